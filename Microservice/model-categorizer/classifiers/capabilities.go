@@ -0,0 +1,93 @@
+package classifiers
+
+import (
+	"sort"
+	"strings"
+)
+
+// capabilitySynonyms maps alternative capability spellings to their canonical value.
+// Only spellings with one meaning belong here: a bare "speech" could be either direction,
+// so it is left as is rather than tagging transcription models as text-to-speech.
+var capabilitySynonyms = map[string]string{
+	"embeddings":         CapEmbedding,
+	"embed":              CapEmbedding,
+	"multimodal":         CapVision,
+	"image-input":        CapVision,
+	"function_calling":   CapFunctionCalling,
+	"function-call":      CapFunctionCalling,
+	"functions":          CapFunctionCalling,
+	"tools":              CapFunctionCalling,
+	"tool-use":           CapFunctionCalling,
+	"stt":                CapSpeechToText,
+	"asr":                CapSpeechToText,
+	"transcription":      CapSpeechToText,
+	"speech-recognition": CapSpeechToText,
+	"tts":                CapTextToSpeech,
+	"speech-synthesis":   CapTextToSpeech,
+	"image generation":   CapImageGeneration,
+	"image_generation":   CapImageGeneration,
+	"text-to-image":      CapImageGeneration,
+	"reranking":          CapRerank,
+	"re-rank":            CapRerank,
+}
+
+// knownCapabilities is the vocabulary of capabilities the classifier can assign
+var knownCapabilities = []string{
+	CapChat,
+	CapVision,
+	CapFunctionCalling,
+	CapEmbedding,
+	CapAudio,
+	CapSpeechToText,
+	CapTextToSpeech,
+	CapRealtime,
+	CapCode,
+	CapReasoning,
+	CapImageGeneration,
+	CapRerank,
+}
+
+// KnownCapabilities returns the sorted capability vocabulary of the classifier
+func KnownCapabilities() []string {
+	result := append([]string(nil), knownCapabilities...)
+	sort.Strings(result)
+	return result
+}
+
+// normalizeCapability collapses a capability synonym into its canonical form
+func normalizeCapability(capability string) string {
+	capLower := strings.ToLower(strings.TrimSpace(capability))
+	if canonical, ok := capabilitySynonyms[capLower]; ok {
+		return canonical
+	}
+	return capLower
+}
+
+// NormalizeCapabilities returns the canonical, de-duplicated and sorted capabilities
+func NormalizeCapabilities(capabilities []string) []string {
+	seen := make(map[string]bool, len(capabilities))
+	result := make([]string, 0, len(capabilities))
+
+	for _, capability := range capabilities {
+		canonical := normalizeCapability(capability)
+		if canonical == "" || seen[canonical] {
+			continue
+		}
+		seen[canonical] = true
+		result = append(result, canonical)
+	}
+
+	sort.Strings(result)
+	return result
+}
+
+// removeCapability returns the capabilities without the given one
+func removeCapability(capabilities []string, capability string) []string {
+	result := make([]string, 0, len(capabilities))
+	for _, c := range capabilities {
+		if c != capability {
+			result = append(result, c)
+		}
+	}
+	return result
+}
@@ -0,0 +1,56 @@
+package classifiers
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNormalizeCapabilities(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []string
+		want []string
+	}{
+		{"synonyms", []string{"Tools", "embeddings", "multimodal"}, []string{CapEmbedding, CapFunctionCalling, CapVision}},
+		{"duplicates", []string{"tools", "function-calling", "function_calling"}, []string{CapFunctionCalling}},
+		{"speech to text", []string{"stt", "asr", "transcription", "speech-recognition"}, []string{CapSpeechToText}},
+		{"text to speech", []string{"tts", "speech-synthesis", "text-to-speech"}, []string{CapTextToSpeech}},
+		// A bare "speech" names no direction, so it is not mapped to either
+		{"ambiguous speech", []string{"speech"}, []string{"speech"}},
+		{"empty values", []string{"", "  "}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeCapabilities(tt.in); !slices.Equal(got, tt.want) {
+				t.Errorf("NormalizeCapabilities(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpeechModelDirection(t *testing.T) {
+	mc := NewModelClassifier()
+
+	tests := []struct {
+		modelID string
+		want    string
+		notWant string
+	}{
+		{"whisper-1", CapSpeechToText, CapTextToSpeech},
+		{"gpt-4o-transcribe", CapSpeechToText, CapTextToSpeech},
+		{"tts-1", CapTextToSpeech, CapSpeechToText},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.modelID, func(t *testing.T) {
+			capabilities := mc.ClassifyModel(tt.modelID, "").Capabilities
+			if !slices.Contains(capabilities, tt.want) {
+				t.Errorf("capabilities %v missing %q", capabilities, tt.want)
+			}
+			if slices.Contains(capabilities, tt.notWant) {
+				t.Errorf("capabilities %v include %q", capabilities, tt.notWant)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package classifiers
+
+import (
+	"regexp"
+	"strings"
+)
+
+// capabilitySupport records capabilities a provider's model does not support even
+// though the type and series heuristics would assign them (e.g. the original GPT-4
+// snapshots are text-only while later GPT-4 models accept images)
+type capabilitySupport struct {
+	provider    string
+	pattern     *regexp.Regexp
+	unsupported []string
+}
+
+// capabilitySupportRegistry lists the known exceptions to the capability heuristics.
+// Patterns match the lowercase model name without any "provider/" prefix; models
+// without an entry keep the heuristic capabilities.
+var capabilitySupportRegistry = []capabilitySupport{
+	{
+		// gpt-4, gpt-4-0314, gpt-4-0613 and the 32k variants predate vision
+		provider:    ProviderOpenAI,
+		pattern:     regexp.MustCompile(`^gpt-4(-32k)?(-\d{4})?$`),
+		unsupported: []string{CapVision},
+	},
+	{
+		// The GPT-4 Turbo previews are text-only; vision arrived with gpt-4-turbo-2024-04-09
+		provider:    ProviderOpenAI,
+		pattern:     regexp.MustCompile(`^gpt-4-(\d{4}-preview|turbo-preview)$`),
+		unsupported: []string{CapVision},
+	},
+	{
+		provider:    ProviderOpenAI,
+		pattern:     regexp.MustCompile(`^o1-(mini|preview)([-_.:]|$)`),
+		unsupported: []string{CapVision, CapFunctionCalling},
+	},
+	{
+		provider:    ProviderOpenAI,
+		pattern:     regexp.MustCompile(`^o3-mini([-_.:]|$)`),
+		unsupported: []string{CapVision},
+	},
+	{
+		// Claude 3.5 Haiku launched without image input, unlike Claude 3 Haiku
+		provider:    ProviderAnthropicA,
+		pattern:     regexp.MustCompile(`^claude-3-5-haiku`),
+		unsupported: []string{CapVision},
+	},
+	{
+		// Gemini 1.0 Pro is text-only; its vision sibling is gemini-pro-vision
+		provider:    ProviderGoogle,
+		pattern:     regexp.MustCompile(`^gemini-(1\.0-)?pro(-\d{3})?$`),
+		unsupported: []string{CapVision},
+	},
+}
+
+// unsupportedCapabilities returns the capabilities the registry rules out for a model,
+// or nil when the model has no entry
+func unsupportedCapabilities(modelName, provider string) []string {
+	modelLower := strings.ToLower(modelName)
+	if idx := strings.LastIndex(modelLower, "/"); idx >= 0 {
+		modelLower = modelLower[idx+1:]
+	}
+
+	for _, support := range capabilitySupportRegistry {
+		if support.provider == provider && support.pattern.MatchString(modelLower) {
+			return support.unsupported
+		}
+	}
+	return nil
+}
+
+// supportsCapability reports whether the registry allows the capability for a model
+func supportsCapability(modelName, provider, capability string) bool {
+	for _, unsupported := range unsupportedCapabilities(modelName, provider) {
+		if unsupported == capability {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,59 @@
+package classifiers
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCapabilitySupportVision(t *testing.T) {
+	mc := NewModelClassifier()
+
+	tests := []struct {
+		modelID    string
+		wantVision bool
+	}{
+		{"gpt-4-0314", false},
+		{"gpt-4-0613", false},
+		{"gpt-4-32k", false},
+		{"openai/gpt-4-0314", false},
+		{"gpt-4-turbo-preview", false},
+		{"gpt-4o", true},
+		{"gpt-4o-2024-08-06", true},
+		{"gpt-4-turbo-2024-04-09", true},
+		{"claude-3-5-haiku-20241022", false},
+		{"claude-3-haiku-20240307", true},
+		{"gemini-pro", false},
+		{"gemini-1.5-pro", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.modelID, func(t *testing.T) {
+			capabilities := mc.ClassifyModel(tt.modelID, "").Capabilities
+			if got := slices.Contains(capabilities, CapVision); got != tt.wantVision {
+				t.Errorf("vision = %v, want %v (capabilities %v)", got, tt.wantVision, capabilities)
+			}
+		})
+	}
+}
+
+func TestUnsupportedCapabilities(t *testing.T) {
+	tests := []struct {
+		modelID  string
+		provider string
+		want     []string
+	}{
+		{"gpt-4-0314", ProviderOpenAI, []string{CapVision}},
+		{"o1-mini", ProviderOpenAI, []string{CapVision, CapFunctionCalling}},
+		// Entries only apply to their own provider
+		{"gpt-4-0314", ProviderAnthropicA, nil},
+		// Unknown models fall back to the heuristics
+		{"gpt-4o", ProviderOpenAI, nil},
+		{"my-custom-model", ProviderOther, nil},
+	}
+
+	for _, tt := range tests {
+		if got := unsupportedCapabilities(tt.modelID, tt.provider); !slices.Equal(got, tt.want) {
+			t.Errorf("unsupportedCapabilities(%q, %q) = %v, want %v", tt.modelID, tt.provider, got, tt.want)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package classifiers
 
 import (
+	"fmt"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -12,9 +14,10 @@ const (
 	// Providers
 	ProviderOpenAI     = "openai"
 	ProviderAnthropicA = "anthropic"
-	ProviderGemini     = "gemini"
+	ProviderGoogle     = "google" // All Google models (Gemini, Gemma, Imagen); Family tells them apart
 	ProviderMeta       = "meta"
 	ProviderMistral    = "mistral"
+	ProviderStability  = "stability"
 	ProviderOther      = "other"
 	ProviderOpenrouter = "openrouter"
 
@@ -23,12 +26,17 @@ const (
 	SeriesClaude2 = "Claude 2"
 	SeriesClaude1 = "Claude 1"
 
+	SeriesStableDiffusion = "Stable Diffusion"
+	SeriesMistral         = "Mistral"
+	SeriesGPTOSS          = "GPT-OSS"
+
 	// OpenAI Types
 	TypeO    = "O Series"
 	Type35   = "GPT 3.5"
 	Type4    = "GPT 4"
 	Type45   = "GPT 4.5"
 	TypeMini = "Mini"
+	TypeOSS  = "GPT OSS"
 
 	// Other Types
 	TypeOpus      = "Opus"
@@ -39,11 +47,42 @@ const (
 	TypeGemma     = "Gemma"
 	TypeFlashLite = "Flash Lite"
 	TypeFlash     = "Flash"
+	TypeLarge     = "Large"
+	TypeMedium    = "Medium"
+	TypeSmall     = "Small"
+	TypeTiny      = "Tiny"
+	TypeMixtral   = "Mixtral"
+	TypeCodestral = "Codestral"
+	TypeMinistral = "Ministral"
 	TypeVision    = "Vision"
 	TypeStandard  = "Standard"
 	TypeEmbedding = "Embedding"
+	TypeReranker  = "Reranker"
 	TypeImage     = "Image Generation"
 
+	// Audio Types
+	TypeSpeech   = "Speech"
+	TypeTTS      = "Text-to-Speech"
+	TypeRealtime = "Realtime"
+
+	// Classification confidence levels
+	ConfidenceRegistry = 1.0 // Exact match in the curated registry
+	ConfidencePattern  = 0.8 // Provider and series both recognized by name patterns
+	ConfidenceProvider = 0.5 // Provider recognized, but only a generic series
+	ConfidenceFallback = 0.2 // Nothing recognized; classified as "other"
+
+	// Input/output modalities
+	ModalityText      = "text"
+	ModalityImage     = "image"
+	ModalityAudio     = "audio"
+	ModalityEmbedding = "embedding"
+	ModalityScore     = "score" // Relevance scores, as produced by rerankers
+
+	// Tuning variants of open-weight models
+	TuningBase     = "base"
+	TuningInstruct = "instruct"
+	TuningChat     = "chat"
+
 	// Version constants for improved consistency
 	Version10 = "1.0"
 	Version15 = "1.5"
@@ -60,61 +99,247 @@ const (
 	CapFunctionCalling = "function-calling"
 	CapEmbedding       = "embedding"
 	CapChat            = "chat"
+	CapAudio           = "audio"
+	CapSpeechToText    = "speech-to-text"
+	CapTextToSpeech    = "text-to-speech"
+	CapRealtime        = "realtime"
+	CapCode            = "code"
+	CapReasoning       = "reasoning"
+	CapImageGeneration = "image-generation"
+	CapRerank          = "rerank"
 )
 
 // ModelMetadata contains organized model information
 type ModelMetadata struct {
-	Provider       string
-	Series         string
-	Type           string
-	Variant        string
-	Context        int
-	Capabilities   []string
-	IsMultimodal   bool
-	IsExperimental bool
-	DisplayName    string
-}
-
-// ModelClassifier helps efficiently classify models
+	Provider         string   `json:"provider,omitempty"`
+	Family           string   `json:"family,omitempty"`     // Brand family (e.g. "GPT"), broader than Series (e.g. "GPT 4")
+	RawFamily        string   `json:"raw_family,omitempty"` // Name token that matched the family (e.g. "gpt"); empty when Family fell back to Series
+	Series           string   `json:"series,omitempty"`
+	Type             string   `json:"type,omitempty"`
+	Variant          string   `json:"variant,omitempty"`
+	Context          int      `json:"context_size,omitempty"`
+	Capabilities     []string `json:"capabilities,omitempty"`
+	IsMultimodal     bool     `json:"is_multimodal,omitempty"`
+	IsExperimental   bool     `json:"is_experimental,omitempty"`
+	DisplayName      string   `json:"display_name,omitempty"`
+	Quantization     string   `json:"quantization,omitempty"`
+	PreviewDate      string   `json:"preview_date,omitempty"` // Raw date of experimental/preview releases (e.g. "03-25")
+	License          string   `json:"license,omitempty"`      // LicenseOpen, LicenseProprietary or LicenseUnknown
+	Tuning           string   `json:"tuning,omitempty"`       // TuningBase, TuningInstruct, TuningChat or empty for hosted API models
+	InputModalities  []string `json:"input_modalities,omitempty"`
+	OutputModalities []string `json:"output_modalities,omitempty"`
+	IsOpenWeight     bool     `json:"is_open_weight,omitempty"`
+	CostPerToken     float64  `json:"cost_per_token,omitempty"`
+	RegistryMatch    bool     `json:"registry_match,omitempty"` // True when authoritative registry metadata was applied
+	Confidence       float32  `json:"confidence,omitempty"`     // 0-1 trust in the classification; see the Confidence* constants
+	IsDistilled      bool     `json:"is_distilled,omitempty"`
+	DistilledFrom    string   `json:"distilled_from,omitempty"`  // Teacher model a distilled model was trained from (e.g. "deepseek-r1")
+	RoutingVariant   string   `json:"routing_variant,omitempty"` // OpenRouter routing suffix without the colon (e.g. "free", "nitro")
+}
+
+// ModelClassifier helps efficiently classify models.
+//
+// A ModelClassifier is safe for concurrent use: all pattern tables, context sizes and
+// registry entries are built in the constructor and only read afterwards. Any mutable
+// state added later (caches, reloadable registries) must carry its own synchronization.
 type ModelClassifier struct {
 	patterns *PatternMatcher
 	context  *ContextResolver
 	defaults *DefaultModels
+	registry *ModelRegistry
+
+	// experimentalOverrides maps lowercase model IDs to a forced experimental flag
+	experimentalOverrides map[string]bool
+
+	// experimentalPattern matches the configured experimental keywords as name tokens
+	experimentalPattern *regexp.Regexp
 }
 
 // NewModelClassifier creates a new model classifier with improved hierarchical patterns
-func NewModelClassifier() *ModelClassifier {
-	return &ModelClassifier{
-		patterns: NewPatternMatcher(),
-		context:  NewContextResolver(),
-		defaults: NewDefaultModels(),
+func NewModelClassifier(opts ...Option) *ModelClassifier {
+	mc := &ModelClassifier{
+		patterns:              NewPatternMatcher(),
+		context:               NewContextResolver(),
+		defaults:              NewDefaultModels(),
+		registry:              NewModelRegistry(),
+		experimentalOverrides: make(map[string]bool),
+		experimentalPattern:   experimentalKeywordPattern(DefaultExperimentalKeywords),
 	}
+	for _, opt := range opts {
+		opt(mc)
+	}
+	return mc
+}
+
+// PatternCount returns the number of name patterns the classifier matches against
+func (mc *ModelClassifier) PatternCount() int {
+	return mc.patterns.PatternCount()
+}
+
+// RegistryModelCount returns the number of well-known models in the embedded registry
+func (mc *ModelClassifier) RegistryModelCount() int {
+	return mc.registry.Len()
 }
 
 // ClassifyModel takes a model id and returns a structured metadata object
 func (mc *ModelClassifier) ClassifyModel(modelID, providerHint string) ModelMetadata {
-	modelLower := strings.ToLower(modelID)
+	// Routing and quantization suffixes are recorded but must not influence the base classification
+	baseID, routingVariant, quantization := splitSuffixes(modelID)
+	modelLower := strings.ToLower(baseID)
+	distillOrigin, distillBase, isDistilled := splitDistilled(modelLower)
 	var metadata ModelMetadata
-	if mc.isImageGenerationModel(modelLower) {
+	if isDistilled {
+		metadata = mc.createDistilledModelMetadata(distillOrigin, distillBase, providerHint)
+	} else if mc.isImageGenerationModel(modelLower) {
 		metadata = mc.createImageGenerationMetadata(modelLower, providerHint)
+	} else if mc.isRerankerModel(modelLower) {
+		metadata = mc.createRerankerModelMetadata(modelLower, providerHint)
 	} else if mc.isEmbeddingModel(modelLower) {
 		metadata = mc.createEmbeddingModelMetadata(modelLower, providerHint)
+	} else if mc.isAudioModel(modelLower) {
+		metadata = mc.createAudioModelMetadata(modelLower, providerHint)
 	} else {
-		metadata = mc.buildStandardModelMetadata(modelLower, providerHint)
+		// Reasoning suffixes describe a mode of the base model, not a separate type
+		baseName, reasoning := stripReasoningSuffix(modelLower)
+		metadata = mc.buildStandardModelMetadata(baseName, providerHint)
+		if reasoning || routingVariant == "thinking" {
+			metadata.Capabilities = NormalizeCapabilities(append(metadata.Capabilities, CapReasoning))
+		}
+	}
+
+	// Family is the brand above the series; fall back to the series when unknown.
+	// Distilled models belong to their student architecture's family.
+	familySource := modelLower
+	if isDistilled {
+		familySource = distillBase
+	}
+	metadata.Family, metadata.RawFamily = mc.patterns.matchFamilyPattern(familySource)
+	if metadata.Family == "" {
+		metadata.Family = metadata.Series
+	}
+
+	// Authoritative registry metadata overrides heuristic guesses for well-known models
+	if entry, ok := mc.registry.Lookup(baseID); ok {
+		entry.apply(&metadata)
+	}
+
+	metadata.Confidence = classificationConfidence(metadata)
+	metadata.InputModalities, metadata.OutputModalities = determineModalities(metadata)
+	metadata.License = determineLicense(baseID, metadata.Provider)
+	metadata.IsOpenWeight = metadata.License == LicenseOpen
+	metadata.Quantization = quantization
+	metadata.RoutingVariant = routingVariant
+	return metadata
+}
+
+// ClassifyModelWithName classifies a model by ID and, when the ID alone gives provider
+// "other", falls back to a "Provider: Model" display name such as OpenRouter's
+// "Anthropic: Claude 3.5 Sonnet". Suffixes recorded from the ID are kept, and a
+// name-derived result is never scored above ConfidencePattern.
+func (mc *ModelClassifier) ClassifyModelWithName(modelID, name, providerHint string) ModelMetadata {
+	metadata, _ := mc.classifyWithName(modelID, name, providerHint, func(id, hint string) (ModelMetadata, []TraceStep) {
+		return mc.ClassifyModel(id, hint), nil
+	})
+	return metadata
+}
+
+// classifyWithName applies the display-name fallback of ClassifyModelWithName around
+// classify, which is ClassifyModel or ClassifyWithTrace, so both share one path
+func (mc *ModelClassifier) classifyWithName(modelID, name, providerHint string, classify func(modelID, providerHint string) (ModelMetadata, []TraceStep)) (ModelMetadata, []TraceStep) {
+	metadata, steps := classify(modelID, providerHint)
+	if metadata.Provider != ProviderOther {
+		return metadata, steps
+	}
+
+	provider, modelName, ok := parseDisplayName(name)
+	if !ok {
+		return metadata, steps
+	}
+	fallback, fallbackSteps := classify(modelName, provider)
+	if fallback.Provider == ProviderOther {
+		return metadata, steps
+	}
+
+	fallback.Quantization = metadata.Quantization
+	fallback.RoutingVariant = metadata.RoutingVariant
+	if fallback.Confidence > ConfidencePattern {
+		fallback.Confidence = ConfidencePattern
+	}
+
+	if steps != nil {
+		fallbackSteps = append([]TraceStep{{Field: "name", Value: name, Rule: "display name fallback: ID matched no provider"}}, fallbackSteps...)
+		if metadata.Quantization != "" {
+			fallbackSteps = append(fallbackSteps, TraceStep{Field: "quantization", Value: metadata.Quantization, Rule: "quantization suffix"})
+		}
+		if metadata.RoutingVariant != "" {
+			fallbackSteps = append(fallbackSteps, TraceStep{Field: "routing_variant", Value: metadata.RoutingVariant, Rule: "routing suffix"})
+		}
+	}
+	return fallback, fallbackSteps
+}
+
+// displayNameQualifierPattern matches parenthesized qualifiers such as "(self-moderated)"
+var displayNameQualifierPattern = regexp.MustCompile(`\s*\([^)]*\)`)
+
+// parseDisplayName splits a "Provider: Model Name" display name into the provider and a
+// model ID-like name ("claude-3.5-sonnet"). It reports false for names without a provider.
+func parseDisplayName(name string) (provider, modelName string, ok bool) {
+	idx := strings.Index(name, ": ")
+	if idx <= 0 {
+		return "", "", false
+	}
+	provider = NormalizeProvider(strings.ToLower(strings.TrimSpace(name[:idx])))
+	modelName = displayNameQualifierPattern.ReplaceAllString(name[idx+2:], "")
+	modelName = strings.Join(strings.Fields(strings.ToLower(modelName)), "-")
+	if provider == "" || modelName == "" {
+		return "", "", false
+	}
+	return provider, modelName, true
+}
+
+// createDistilledModelMetadata classifies a distilled model by its base architecture
+// (e.g. Qwen for "deepseek-r1-distill-qwen-32b") while recording the teacher it was distilled from
+func (mc *ModelClassifier) createDistilledModelMetadata(origin, base, providerHint string) ModelMetadata {
+	metadata := mc.buildStandardModelMetadata(base, providerHint)
+	metadata.IsDistilled = true
+	metadata.DistilledFrom = origin
+
+	// Distillation fine-tunes the student, so it is never a raw base checkpoint
+	if metadata.Tuning == TuningBase || metadata.Tuning == "" {
+		metadata.Tuning = TuningInstruct
+	}
+	capabilities := append(metadata.Capabilities, CapChat)
+
+	// R1 distills inherit the teacher's step-by-step reasoning
+	if strings.Contains(origin, "r1") {
+		capabilities = append(capabilities, CapReasoning)
 	}
+	metadata.Capabilities = NormalizeCapabilities(capabilities)
+	metadata.IsExperimental = mc.isExperimental(origin + "-" + base)
+
 	return metadata
 }
 
 // createImageGenerationMetadata creates metadata for image generation models
 func (mc *ModelClassifier) createImageGenerationMetadata(modelName, providerHint string) ModelMetadata {
-	return ModelMetadata{
+	metadata := ModelMetadata{
 		Provider:     mc.determineProvider(modelName, providerHint),
 		Series:       TypeImage,
 		Type:         TypeImage,
 		Variant:      "Image Generation",
-		Capabilities: []string{TypeImage},
+		Capabilities: []string{CapImageGeneration},
 		IsMultimodal: false,
 	}
+
+	// Stability models are grouped under the Stable Diffusion family
+	if metadata.Provider == ProviderStability {
+		metadata.Series = SeriesStableDiffusion
+		if variant := mc.patterns.matchStabilityVariant(modelName); variant != "" {
+			metadata.Variant = variant
+		}
+	}
+
+	return metadata
 }
 
 // createEmbeddingModelMetadata creates metadata for embedding models
@@ -129,6 +354,43 @@ func (mc *ModelClassifier) createEmbeddingModelMetadata(modelName, providerHint
 	}
 }
 
+// createRerankerModelMetadata creates metadata for reranker models, which score
+// query-document pairs instead of producing embeddings
+func (mc *ModelClassifier) createRerankerModelMetadata(modelName, providerHint string) ModelMetadata {
+	return ModelMetadata{
+		Provider:     mc.determineProvider(modelName, providerHint),
+		Series:       TypeReranker,
+		Type:         TypeReranker,
+		Variant:      "Reranker",
+		Capabilities: []string{CapRerank},
+		IsMultimodal: false,
+	}
+}
+
+// createAudioModelMetadata creates metadata for speech-to-text, text-to-speech and realtime models
+func (mc *ModelClassifier) createAudioModelMetadata(modelName, providerHint string) ModelMetadata {
+	audioType, audioCapability := mc.patterns.matchAudioType(modelName)
+
+	metadata := ModelMetadata{
+		Provider:       mc.determineProvider(modelName, providerHint),
+		Series:         "Audio",
+		Type:           audioType,
+		Variant:        audioType,
+		Capabilities:   []string{CapAudio, audioCapability},
+		IsExperimental: mc.isExperimental(modelName),
+	}
+
+	// Realtime models are bidirectional and keep a full chat context
+	if audioType == TypeRealtime {
+		metadata.Context = mc.GetContextSize(modelName)
+		metadata.Capabilities = append(metadata.Capabilities, CapChat)
+		metadata.IsMultimodal = true
+	}
+
+	sort.Strings(metadata.Capabilities)
+	return metadata
+}
+
 // buildStandardModelMetadata builds metadata for standard LLM models
 func (mc *ModelClassifier) buildStandardModelMetadata(modelName, providerHint string) ModelMetadata {
 	// Start with empty metadata
@@ -143,143 +405,233 @@ func (mc *ModelClassifier) buildStandardModelMetadata(modelName, providerHint st
 	// Determine type based on provider and series
 	metadata.Type = mc.determineType(modelName, metadata.Provider, metadata.Series)
 
-	// Determine variant (version)
-	metadata.Variant = mc.determineVariant(modelName, metadata.Provider, metadata.Series)
+	// Determine variant (version) from the alias so dated snapshots share their alias's variant.
+	// Mistral encodes the Large generation in its snapshot date, so it keeps the full name.
+	alias, _ := mc.ResolveSnapshot(modelName)
+	if metadata.Provider == ProviderMistral {
+		alias = modelName
+	}
+	metadata.Variant = mc.determineVariant(alias, metadata.Provider, metadata.Series)
 
-	// Determine context size
-	metadata.Context = mc.GetContextSize(modelName)
+	// Determine context size, falling back to the provider's default for unrecognized models
+	metadata.Context = mc.context.GetContextSizeForProvider(modelName, metadata.Provider)
 
 	// Determine capabilities
 	metadata.Capabilities = mc.detectCapabilities(modelName, metadata.Provider, metadata.Series)
 
+	// Base (pretrained-only) checkpoints are not tuned to follow chat turns
+	metadata.Tuning = mc.patterns.matchTuning(modelName, determineLicense(modelName, metadata.Provider) == LicenseOpen)
+	if metadata.Tuning == TuningBase {
+		metadata.Capabilities = removeCapability(metadata.Capabilities, CapChat)
+	}
+
 	// Set multimodal flag
 	metadata.IsMultimodal = mc.isMultimodal(modelName, metadata.Capabilities, metadata.Series)
 
 	// Set experimental flag
 	metadata.IsExperimental = mc.isExperimental(modelName)
 
+	// Preserve the raw preview date that is stripped from Gemini variants
+	if metadata.Provider == ProviderGoogle {
+		_, metadata.PreviewDate = splitGeminiPreview(modelName)
+	}
+
 	return metadata
 }
 
 // determineProvider identifies the model provider from name
 func (mc *ModelClassifier) determineProvider(modelName, providerHint string) string {
+	provider, _ := mc.providerRule(modelName, providerHint)
+	return provider
+}
+
+// providerRule identifies the model provider from name along with the rule that decided it
+func (mc *ModelClassifier) providerRule(modelName, providerHint string) (string, string) {
 	// Check provider hint first if provided
 	if providerHint != "" {
-		providerLower := strings.ToLower(providerHint)
-		if provider := mc.patterns.matchProviderByName(providerLower); provider != "" {
-			return provider
+		if provider := mc.patterns.matchProviderByName(NormalizeProvider(providerHint)); provider != "" {
+			return provider, fmt.Sprintf("provider hint %q", providerHint)
 		}
 	}
 
 	// Handle OpenRouter prefix: "provider/model"
 	if strings.Contains(modelName, "/") {
 		parts := strings.SplitN(modelName, "/", 2)
-		potentialProvider := strings.ToLower(parts[0])
-		if provider := mc.patterns.matchProviderByName(potentialProvider); provider != "" {
-			return provider
+		if provider := mc.patterns.matchProviderByName(NormalizeProvider(parts[0])); provider != "" {
+			return provider, fmt.Sprintf("prefix %q", parts[0]+"/")
 		}
 	}
 
 	// Match provider by patterns
-	if provider := mc.patterns.matchProviderByPattern(modelName); provider != "" {
-		return provider
+	if provider, pattern := mc.patterns.matchProviderPattern(modelName); provider != "" {
+		return provider, fmt.Sprintf("provider pattern %q", pattern)
 	}
 
 	// Default provider if none matched
-	return ProviderOther
+	return ProviderOther, "default"
 }
 
 // determineSeries identifies the model series based on name and provider
 func (mc *ModelClassifier) determineSeries(modelName, provider string) string {
+	series, _ := mc.seriesRule(modelName, provider)
+	return series
+}
+
+// seriesRule identifies the model series based on name and provider along with the rule that decided it
+func (mc *ModelClassifier) seriesRule(modelName, provider string) (string, string) {
 	// Provider-specific series determination
 	switch provider {
 	case ProviderOpenAI:
-		if modelName[0] == 'o' {
-			return "O"
+		// Open-weight GPT-OSS is its own line, not part of the GPT-4/3.5 series
+		if strings.Contains(strings.ToLower(modelName), "gpt-oss") {
+			return SeriesGPTOSS, `name contains "gpt-oss"`
+		}
+		// Judge the name without any "openai/" prefix; other "o" names such as
+		// omni-moderation are not O-series models
+		baseName := strings.ToLower(modelName)
+		if idx := strings.LastIndex(baseName, "/"); idx >= 0 {
+			baseName = baseName[idx+1:]
+		}
+		if oSeriesPattern.MatchString(baseName) {
+			return "O", "openai o-series name"
 		}
-		if modelName[0] == 'g' {
-			return "GPT"
+		if strings.HasPrefix(baseName, "g") {
+			return "GPT", `openai name starts with "g"`
 		}
-		if modelName[0] == 'd' {
-			return "DALL-E"
+		if strings.HasPrefix(baseName, "d") {
+			return "DALL-E", `openai name starts with "d"`
 		}
 	case ProviderAnthropicA:
-		if series := mc.patterns.matchClaudeVersion(modelName); series != "" {
-			return series
+		if series, pattern := mc.patterns.matchClaudeVersionPattern(modelName); series != "" {
+			return series, fmt.Sprintf("claude version pattern %q", pattern)
 		}
 
-	case ProviderGemini:
-		return mc.patterns.matchGeminiVersion(modelName)
+	case ProviderGoogle:
+		if series := mc.patterns.matchGemmaVersion(modelName); series != "" {
+			return series, "gemma version"
+		}
+		return mc.patterns.matchGeminiVersion(modelName), "gemini version"
+
+	case ProviderMeta:
+		if series := mc.patterns.matchLlamaVersion(modelName); series != "" {
+			return series, "llama version"
+		}
+
+	case ProviderMistral:
+		return SeriesMistral, "mistral provider"
 	}
 
 	// Generic fallback series detection
-	if series := mc.patterns.matchSeriesByPattern(modelName); series != "" {
-		return series
+	if series, pattern := mc.patterns.matchSeriesPattern(modelName); series != "" {
+		return series, fmt.Sprintf("series pattern %q", pattern)
 	}
 
 	// Default series if none matched
-	return "General"
+	return "General", "default"
 }
 
 // determineType identifies the model type based on name, provider and series
 func (mc *ModelClassifier) determineType(modelName, provider, series string) string {
+	type_, _ := mc.typeRule(modelName, provider, series)
+	return type_
+}
+
+// typeRule identifies the model type based on name, provider and series along with the rule that decided it
+func (mc *ModelClassifier) typeRule(modelName, provider, series string) (string, string) {
 	modelLower := strings.ToLower(modelName)
 
 	// Provider-specific type determination
 	switch provider {
 	case ProviderOpenAI:
-		return mc.patterns.matchOpenAIType(modelLower)
+		return mc.patterns.matchOpenAIType(modelLower), "openai type rules"
 
 	case ProviderAnthropicA:
-		return mc.patterns.matchAnthropicType(modelLower)
+		return mc.patterns.matchAnthropicType(modelLower), "anthropic type rules"
+
+	case ProviderGoogle:
+		return mc.patterns.matchGeminiType(modelLower), "gemini type rules"
 
-	case ProviderGemini:
-		return mc.patterns.matchGeminiType(modelLower)
+	case ProviderMistral:
+		return mc.patterns.matchMistralType(modelLower), "mistral type rules"
 	}
 
 	// Generic type detection based on patterns
-	if type_ := mc.patterns.matchTypeByPattern(modelLower); type_ != "" {
-		return type_
+	if type_, pattern := mc.patterns.matchTypePattern(modelLower); type_ != "" {
+		return type_, fmt.Sprintf("type pattern %q", pattern)
 	}
 
 	// Default type if none matched
-	return TypeStandard
+	return TypeStandard, "default"
 }
 
 // determineVariant extracts specific version information
 func (mc *ModelClassifier) determineVariant(modelName, provider, series string) string {
+	variant, _ := mc.variantRule(modelName, provider, series)
+	return variant
+}
+
+// variantRule extracts specific version information along with the rule that decided it
+func (mc *ModelClassifier) variantRule(modelName, provider, series string) (string, string) {
 	modelLower := strings.ToLower(modelName)
 
 	// Provider-specific variant detection
 	switch provider {
 	case ProviderOpenAI:
 		if variant := mc.patterns.matchOpenAIVariant(modelLower); variant != "" {
-			return variant
+			return variant, "openai variant rules"
 		}
 
 	case ProviderAnthropicA:
 		if variant := mc.patterns.matchAnthropicVariant(modelLower); variant != "" {
-			return variant
+			return variant, "anthropic variant rules"
 		}
 
-	case ProviderGemini:
+	case ProviderGoogle:
+		if variant := mc.patterns.buildGemmaVariant(modelLower, series); variant != "" {
+			return variant, "gemma variant"
+		}
 		if variant := mc.patterns.buildGeminiVariant(modelLower); variant != "" {
-			return variant
+			return variant, "gemini variant"
+		}
+
+	case ProviderMeta:
+		if variant := mc.patterns.buildLlamaVariant(modelLower, series); variant != "" {
+			return variant, "llama variant"
+		}
+
+	case ProviderMistral:
+		if variant := mc.patterns.buildMistralVariant(modelLower); variant != "" {
+			return variant, "mistral variant"
 		}
 	}
 
 	// If we couldn't determine a specific variant, try to extract version info
 	if variant := extractVersionVariant(modelName, series); variant != "" {
-		return variant
+		return variant, "version suffix"
 	}
 
 	// Default to series name if no specific variant is found
-	return series
+	return series, "series fallback"
 }
 
 // detectCapabilities identifies model capabilities from the model name
 func (mc *ModelClassifier) detectCapabilities(modelName, provider, series string) []string {
-	capabilities := make(map[string]bool)
+	capabilities := mc.capabilityRules(modelName, provider, series)
+
+	// Convert map to slice
+	result := make([]string, 0, len(capabilities))
+	for cap := range capabilities {
+		result = append(result, cap)
+	}
+
+	// Collapse synonyms into canonical values, de-duplicated and sorted for consistency
+	return NormalizeCapabilities(result)
+}
+
+// capabilityRules identifies model capabilities from the model name, mapping each
+// capability to the rule that assigned it
+func (mc *ModelClassifier) capabilityRules(modelName, provider, series string) map[string]string {
+	capabilities := make(map[string]string)
 	modelLower := strings.ToLower(modelName)
 
 	// Get model type for provider-specific rules
@@ -289,20 +641,9 @@ func (mc *ModelClassifier) detectCapabilities(modelName, provider, series string
 	mc.patterns.addCapabilities(capabilities, modelType, modelLower, provider, series)
 
 	// Chat capability for all models (default)
-	capabilities[CapChat] = true
+	capabilities[CapChat] = "default"
 
-	// Convert map to slice
-	result := make([]string, 0, len(capabilities))
-	for cap := range capabilities {
-		result = append(result, cap)
-	}
-
-	// Sort capabilities alphabetically for consistency
-	sort.Slice(result, func(i, j int) bool {
-		return strings.ToLower(result[i]) < strings.ToLower(result[j])
-	})
-
-	return result
+	return capabilities
 }
 
 // isEmbeddingModel checks if a model is for embeddings
@@ -313,13 +654,31 @@ func (mc *ModelClassifier) isEmbeddingModel(modelName string) bool {
 		strings.Contains(modelLower, "text-embedding")
 }
 
+// isRerankerModel checks if a model is a reranker (e.g. "rerank-english-v3.0" or
+// "bge-reranker-v2-m3"). Checked before embeddings, since rerankers are often
+// published alongside, and named like, embedding models.
+func (mc *ModelClassifier) isRerankerModel(modelName string) bool {
+	return strings.Contains(strings.ToLower(modelName), "rerank")
+}
+
+// isAudioModel checks if a model is a speech-to-text, text-to-speech or realtime audio model
+func (mc *ModelClassifier) isAudioModel(modelName string) bool {
+	audioType, _ := mc.patterns.matchAudioType(modelName)
+	return audioType != ""
+}
+
 // isImageGenerationModel checks if a model is for image generation
 func (mc *ModelClassifier) isImageGenerationModel(modelName string) bool {
 	modelLower := strings.ToLower(modelName)
 	return strings.Contains(modelLower, "dall-e") ||
+		strings.Contains(modelLower, "gpt-image") ||
+		strings.Contains(modelLower, "imagen") ||
+		strings.Contains(modelLower, "flux") ||
 		strings.Contains(modelLower, "image") ||
 		strings.Contains(modelLower, "midjourney") ||
-		strings.Contains(modelLower, "stable-diffusion")
+		strings.Contains(modelLower, "stable-diffusion") ||
+		strings.Contains(modelLower, "sdxl") ||
+		strings.Contains(modelLower, "sd3")
 }
 
 // isMultimodal determines if a model has multimodal capabilities
@@ -333,7 +692,11 @@ func (mc *ModelClassifier) isMultimodal(modelName string, capabilities []string,
 
 	// Extract model type from name to handle models
 	modelLower := strings.ToLower(modelName)
-	modelType := mc.determineType(modelLower, mc.determineProvider(modelLower, ""), series)
+	provider := mc.determineProvider(modelLower, "")
+	if !supportsCapability(modelLower, provider, CapVision) {
+		return false
+	}
+	modelType := mc.determineType(modelLower, provider, series)
 
 	// Check for multimodal capabilities based on type and series
 	if modelType == Type4 || modelType == Type45 || modelType == TypeO ||
@@ -346,17 +709,91 @@ func (mc *ModelClassifier) isMultimodal(modelName string, capabilities []string,
 		strings.Contains(modelLower, "multimodal")
 }
 
-// isExperimental checks if a model is experimental
+// DefaultExperimentalKeywords are the name markers that flag a model as experimental
+var DefaultExperimentalKeywords = []string{
+	"experimental", "exp", "preview", "alpha", "beta", "rc", "canary", "nightly",
+}
+
+// experimentalKeywordPattern builds a pattern matching any keyword as a standalone name
+// token, optionally followed by digits: "exp" matches "gemini-2.0-flash-exp" and "rc"
+// matches "model-rc1", but neither matches inside "expert" or "orca"
+func experimentalKeywordPattern(keywords []string) *regexp.Regexp {
+	quoted := make([]string, 0, len(keywords))
+	for _, keyword := range keywords {
+		if keyword = strings.ToLower(strings.TrimSpace(keyword)); keyword != "" {
+			quoted = append(quoted, regexp.QuoteMeta(keyword))
+		}
+	}
+	if len(quoted) == 0 {
+		return nil
+	}
+	return regexp.MustCompile(`(^|[-_.:/])(` + strings.Join(quoted, "|") + `)\d*([-_.:]|$)`)
+}
+
+// classificationConfidence scores how much of the metadata came from reliable sources:
+// registry matches are authoritative, pattern matches less so, and "other" fallbacks least
+func classificationConfidence(metadata ModelMetadata) float32 {
+	switch {
+	case metadata.RegistryMatch:
+		return ConfidenceRegistry
+	case metadata.Provider == ProviderOther:
+		return ConfidenceFallback
+	case metadata.Series == "" || metadata.Series == "General":
+		return ConfidenceProvider
+	default:
+		return ConfidencePattern
+	}
+}
+
+// determineModalities derives what a model accepts and produces from its type and
+// capabilities, separating image understanding (vision) from image generation
+func determineModalities(metadata ModelMetadata) (input, output []string) {
+	switch metadata.Type {
+	case TypeImage:
+		return []string{ModalityText}, []string{ModalityImage}
+	case TypeEmbedding:
+		return []string{ModalityText}, []string{ModalityEmbedding}
+	case TypeReranker:
+		return []string{ModalityText}, []string{ModalityScore}
+	case TypeSpeech:
+		return []string{ModalityAudio}, []string{ModalityText}
+	case TypeTTS:
+		return []string{ModalityText}, []string{ModalityAudio}
+	case TypeRealtime:
+		return []string{ModalityText, ModalityAudio}, []string{ModalityText, ModalityAudio}
+	}
+
+	input = []string{ModalityText}
+	for _, capability := range metadata.Capabilities {
+		switch capability {
+		case CapVision:
+			input = append(input, ModalityImage)
+		case CapAudio:
+			input = append(input, ModalityAudio)
+		}
+	}
+	return input, []string{ModalityText}
+}
+
+// isExperimental checks if a model is experimental. Configured overrides win over the heuristic.
 func (mc *ModelClassifier) isExperimental(modelName string) bool {
 	modelLower := strings.ToLower(modelName)
-	return strings.Contains(modelLower, "experimental") ||
-		strings.Contains(modelLower, "preview") ||
-		strings.Contains(modelLower, "alpha") ||
-		strings.Contains(modelLower, "beta")
+	if forced, ok := mc.experimentalOverrides[modelLower]; ok {
+		return forced
+	}
+	if idx := strings.LastIndex(modelLower, "/"); idx >= 0 {
+		if forced, ok := mc.experimentalOverrides[modelLower[idx+1:]]; ok {
+			return forced
+		}
+	}
+
+	return mc.experimentalPattern != nil && mc.experimentalPattern.MatchString(modelLower)
 }
 
-// IsDefaultModelName checks if a model is a default version
+// IsDefaultModelName checks if a model is a default version. The lookup ignores case,
+// routing suffixes and OpenRouter-style provider prefixes, so "OpenAI/GPT-4O" matches "gpt-4o".
 func (mc *ModelClassifier) IsDefaultModelName(modelName string) bool {
+	modelName = NormalizeModelName(modelName, "openrouter")
 	return mc.defaults.IsDefaultModel(modelName) ||
 		strings.Contains(strings.ToLower(modelName), "latest")
 }
@@ -379,8 +816,46 @@ func GetSeriesAndVariant(modelID string) (string, string) {
 	return metadata.Series, metadata.Variant
 }
 
-// NormalizeModelName removes provider prefixes from OpenRouter model IDs
+// openRouterRoutingVariants are OpenRouter ":suffix" routing variants; they change
+// pricing, context or routing but not which model is served
+var openRouterRoutingVariants = map[string]bool{
+	"free":     true,
+	"nitro":    true,
+	"extended": true,
+	"beta":     true,
+	"floor":    true,
+	"online":   true,
+	"thinking": true,
+}
+
+// SplitRoutingVariant splits an OpenRouter routing suffix from a model ID, returning the
+// base ID and the variant: "meta-llama/llama-3.1-8b-instruct:free" gives
+// "meta-llama/llama-3.1-8b-instruct" and "free". Other ":" suffixes, such as Ollama
+// size tags ("llama3:8b"), are left in place.
+func SplitRoutingVariant(modelID string) (string, string) {
+	idx := strings.LastIndex(modelID, ":")
+	// A bare suffix such as ":free" is the whole ID, not a routing variant
+	if idx <= 0 || !openRouterRoutingVariants[strings.ToLower(modelID[idx+1:])] {
+		return modelID, ""
+	}
+	return modelID[:idx], strings.ToLower(modelID[idx+1:])
+}
+
+// splitSuffixes strips the routing and quantization suffixes ClassifyModel ignores.
+// An ID that is nothing but a suffix (e.g. ":free" or "-q4") is kept as written.
+func splitSuffixes(modelID string) (baseID, routingVariant, quantization string) {
+	baseID, routingVariant = SplitRoutingVariant(modelID)
+	baseID, quantization = ExtractQuantization(baseID)
+	if baseID == "" {
+		return modelID, "", ""
+	}
+	return baseID, routingVariant, quantization
+}
+
+// NormalizeModelName removes routing suffixes and, for OpenRouter, provider prefixes from model IDs
 func NormalizeModelName(modelID, provider string) string {
+	modelID, _ = SplitRoutingVariant(modelID)
+
 	// Handle OpenRouter models which often contain provider names
 	if strings.ToLower(provider) == "openrouter" {
 		// Remove provider prefixes like "anthropic/" or "openai/"
@@ -400,24 +875,88 @@ func NormalizeModelName(modelID, provider string) string {
 	return modelID
 }
 
+// snapshotPattern matches dated snapshot suffixes: "-2024-08-06", "-20241022", "-0613" or "-2411"
+var snapshotPattern = regexp.MustCompile(`^(.+?)-(\d{4}-\d{2}-\d{2}|\d{8}|\d{4})$`)
+
+// ResolveSnapshot links a dated snapshot ID to its alias. For date-suffixed IDs it
+// returns the alias stem and the snapshot date; "-latest" IDs return their stem with
+// no date, and any other ID is returned unchanged as its own alias.
+func (mc *ModelClassifier) ResolveSnapshot(modelID string) (alias string, snapshotDate string) {
+	return splitSnapshot(modelID)
+}
+
+// splitSnapshot implements ResolveSnapshot; it needs no classifier state
+func splitSnapshot(modelID string) (alias string, snapshotDate string) {
+	if match := snapshotPattern.FindStringSubmatch(modelID); match != nil {
+		return match[1], match[2]
+	}
+	if strings.HasSuffix(strings.ToLower(modelID), "-latest") {
+		return modelID[:len(modelID)-len("-latest")], ""
+	}
+	return modelID, ""
+}
+
+// quantizationPattern matches common quantization/precision suffixes such as
+// "-q4_K_M", "-q8_0", "-fp8", "-awq" or "-int4" at the end of a model ID
+var quantizationPattern = regexp.MustCompile(`(?i)[-_:.]((?:q[2-8](?:_[0-9a-z]+)*)|fp8|fp16|bf16|int4|int8|awq|gptq|gguf|[48]bit)$`)
+
+// ExtractQuantization splits a quantization suffix from a model ID, returning the
+// base ID and the quantization (in its original casing), or an empty quantization if none.
+// The suffix is never the whole ID: "-q4" alone is returned unchanged.
+func ExtractQuantization(modelID string) (string, string) {
+	loc := quantizationPattern.FindStringSubmatchIndex(modelID)
+	if loc == nil || loc[0] == 0 {
+		return modelID, ""
+	}
+	return modelID[:loc[0]], modelID[loc[2]:loc[3]]
+}
+
 // extractVersionVariant extracts version info from a model name
 func extractVersionVariant(modelName, series string) string {
-	versionNumbers := ExtractVersionNumbers(modelName)
+	major, minor, ok := ParseModelVersion(modelName)
+	if !ok {
+		return ""
+	}
+	if minor > 0 {
+		return fmt.Sprintf("%s %d.%d", series, major, minor)
+	}
+	return fmt.Sprintf("%s %d", series, major)
+}
 
-	if len(versionNumbers) > 0 {
-		versionStr := strings.Join(strings.Fields(strings.Map(func(r rune) rune {
-			if r >= '0' && r <= '9' || r == '.' {
-				return r
-			}
-			return ' '
-		}, modelName)), ".")
+// modelVersionPattern matches a one- or two-part model version spelled "3", "3.5", "3-5"
+// or "3p5" that is not part of a longer number such as a date
+var modelVersionPattern = regexp.MustCompile(`(?:^|[^\d.])(\d{1,2})(?:[.p-](\d{1,2}))?(?:([^\d.])|$)`)
+
+// isSizeSuffix reports whether the character following a number marks a parameter
+// size or context length (e.g. "70b", "8x7b", "128k") rather than a version
+func isSizeSuffix(s string) bool {
+	return s == "b" || s == "x" || s == "k" || s == "m"
+}
+
+// ParseModelVersion extracts the model version from a name, understanding "3.5", "3-5",
+// "3p5" and bare majors ("gpt-4o"). Parameter sizes and dates are not treated as versions.
+func ParseModelVersion(name string) (major, minor int, ok bool) {
+	nameLower := strings.ToLower(name)
+
+	for _, match := range modelVersionPattern.FindAllStringSubmatch(nameLower, -1) {
+		trailer := match[3]
+		if match[2] != "" && isSizeSuffix(trailer) {
+			// "llama-3-8b": the second number is a size, so only the major is a version
+			major, _ = strconv.Atoi(match[1])
+			return major, 0, true
+		}
+		if isSizeSuffix(trailer) {
+			continue
+		}
 
-		if versionStr != "" {
-			return series + " " + versionStr
+		major, _ = strconv.Atoi(match[1])
+		if match[2] != "" {
+			minor, _ = strconv.Atoi(match[2])
 		}
+		return major, minor, true
 	}
 
-	return ""
+	return 0, 0, false
 }
 
 // ExtractVersionNumbers extracts numeric parts from version strings
@@ -490,32 +1029,17 @@ func IsNewerVersion(a, b string) bool {
 	return a > b
 }
 
-// GetStandardizedVersion returns a standardized version string from a model name
+// GetStandardizedVersion returns a standardized "major.minor" version string (e.g. "3.5",
+// "4.0") from a model name, or an empty string if no version is identified
 func (mc *ModelClassifier) GetStandardizedVersion(modelName string) string {
-	// Convert to lowercase for consistent matching
-	modelLower := strings.ToLower(modelName)
+	// Ignore any "provider/" prefix so organization names can't contribute digits
+	if idx := strings.LastIndex(modelName, "/"); idx >= 0 {
+		modelName = modelName[idx+1:]
+	}
 
-	// Check for specific version numbers
-	if strings.Contains(modelLower, "4.5") {
-		return Version45
-	} else if strings.Contains(modelLower, "4.0") || strings.Contains(modelLower, "4o") {
-		return Version40
-	} else if strings.Contains(modelLower, "3.7") {
-		return Version37
-	} else if strings.Contains(modelLower, "3.5") {
-		return Version35
-	} else if strings.Contains(modelLower, "3.0") {
-		return Version30
-	} else if strings.Contains(modelLower, "2.5") {
-		return Version25
-	} else if strings.Contains(modelLower, "2.0") {
-		return Version20
-	} else if strings.Contains(modelLower, "1.5") {
-		return Version15
-	} else if strings.Contains(modelLower, "1.0") {
-		return Version10
-	}
-
-	// If no version is identified, return empty string
-	return ""
+	major, minor, ok := ParseModelVersion(modelName)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%d.%d", major, minor)
 }
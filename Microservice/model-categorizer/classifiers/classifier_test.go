@@ -0,0 +1,295 @@
+package classifiers
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestClassifyModelDegenerateIDs(t *testing.T) {
+	mc := NewModelClassifier()
+
+	tests := []struct {
+		name         string
+		modelID      string
+		providerHint string
+		wantProvider string
+	}{
+		{"empty ID", "", ProviderOpenAI, ProviderOpenAI},
+		{"empty ID without hint", "", "", ProviderOther},
+		{"routing suffix only", ":free", ProviderOpenAI, ProviderOpenAI},
+		{"routing suffix only without hint", ":nitro", "", ProviderOther},
+		{"routing suffix for anthropic", ":free", ProviderAnthropicA, ProviderAnthropicA},
+		{"quantization marker only", "-q4", ProviderOpenAI, ProviderOpenAI},
+		{"gguf marker only", "-gguf", ProviderOpenAI, ProviderOpenAI},
+		{"distill marker only", "-distill", ProviderOpenAI, ProviderOpenAI},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metadata := mc.ClassifyModel(tt.modelID, tt.providerHint)
+			if metadata.Provider != tt.wantProvider {
+				t.Errorf("ClassifyModel(%q, %q).Provider = %q, want %q", tt.modelID, tt.providerHint, metadata.Provider, tt.wantProvider)
+			}
+			if metadata.RoutingVariant != "" || metadata.Quantization != "" {
+				t.Errorf("ClassifyModel(%q, %q) stripped suffixes (%q, %q) from a suffix-only ID",
+					tt.modelID, tt.providerHint, metadata.RoutingVariant, metadata.Quantization)
+			}
+
+			// The trace follows the same path and must not panic either
+			mc.ClassifyWithTrace(tt.modelID, tt.providerHint)
+		})
+	}
+}
+
+func TestSplitRoutingVariant(t *testing.T) {
+	tests := []struct {
+		modelID     string
+		wantBase    string
+		wantVariant string
+	}{
+		{"meta-llama/llama-3.1-8b-instruct:free", "meta-llama/llama-3.1-8b-instruct", "free"},
+		{"deepseek/deepseek-r1:NITRO", "deepseek/deepseek-r1", "nitro"},
+		{"llama3:8b", "llama3:8b", ""},
+		{":free", ":free", ""},
+		{"", "", ""},
+	}
+
+	for _, tt := range tests {
+		base, variant := SplitRoutingVariant(tt.modelID)
+		if base != tt.wantBase || variant != tt.wantVariant {
+			t.Errorf("SplitRoutingVariant(%q) = (%q, %q), want (%q, %q)", tt.modelID, base, variant, tt.wantBase, tt.wantVariant)
+		}
+	}
+}
+
+func TestExtractQuantization(t *testing.T) {
+	tests := []struct {
+		modelID          string
+		wantBase         string
+		wantQuantization string
+	}{
+		{"llama3:8b-instruct-q4_K_M", "llama3:8b-instruct", "q4_K_M"},
+		{"qwen2.5-72b-instruct-AWQ", "qwen2.5-72b-instruct", "AWQ"},
+		{"mistral-7b-instruct.gguf", "mistral-7b-instruct", "gguf"},
+		{"deepseek-v3-fp8", "deepseek-v3", "fp8"},
+		{"gpt-4o", "gpt-4o", ""},
+		{"-q4", "-q4", ""},
+		{"-gguf", "-gguf", ""},
+		{"-distill", "-distill", ""},
+		{"", "", ""},
+	}
+
+	for _, tt := range tests {
+		base, quantization := ExtractQuantization(tt.modelID)
+		if base != tt.wantBase || quantization != tt.wantQuantization {
+			t.Errorf("ExtractQuantization(%q) = (%q, %q), want (%q, %q)", tt.modelID, base, quantization, tt.wantBase, tt.wantQuantization)
+		}
+	}
+}
+
+func TestGoogleProviderAliases(t *testing.T) {
+	mc := NewModelClassifier()
+
+	for _, hint := range []string{"google", "gemini", "Gemini", "vertex", "google-ai"} {
+		t.Run(hint, func(t *testing.T) {
+			if got := NormalizeProvider(hint); got != ProviderGoogle {
+				t.Errorf("NormalizeProvider(%q) = %q, want %q", hint, got, ProviderGoogle)
+			}
+			if got := mc.ClassifyModel("gemini-2.0-flash", hint).Provider; got != ProviderGoogle {
+				t.Errorf("ClassifyModel(gemini-2.0-flash, %q).Provider = %q, want %q", hint, got, ProviderGoogle)
+			}
+		})
+	}
+
+	// Registry entries and unhinted IDs resolve to the canonical id too
+	for _, modelID := range []string{"gemini-1.5-pro", "gemma-2-9b-it", "imagen-3.0-generate-002"} {
+		if got := mc.ClassifyModel(modelID, "").Provider; got != ProviderGoogle {
+			t.Errorf("ClassifyModel(%q).Provider = %q, want %q", modelID, got, ProviderGoogle)
+		}
+	}
+}
+
+func TestOpenAISeries(t *testing.T) {
+	mc := NewModelClassifier()
+
+	tests := []struct {
+		modelID    string
+		wantSeries string
+	}{
+		{"o1", "O"},
+		{"o3-mini", "O"},
+		{"openai/o4-mini", "O"},
+		{"gpt-4o", "GPT"},
+		{"openai/gpt-4o", "GPT"},
+		{"openai/gpt-4o-mini", "GPT"},
+		{"omni-moderation-latest", "General"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.modelID, func(t *testing.T) {
+			if got := mc.ClassifyModel(tt.modelID, ProviderOpenAI).Series; got != tt.wantSeries {
+				t.Errorf("ClassifyModel(%q).Series = %q, want %q", tt.modelID, got, tt.wantSeries)
+			}
+		})
+	}
+}
+
+func TestAudioModelTypes(t *testing.T) {
+	mc := NewModelClassifier()
+
+	tests := []struct {
+		modelID      string
+		wantType     string
+		wantCaps     []string
+		wantRealtime bool
+	}{
+		{"whisper-1", TypeSpeech, []string{CapAudio, CapSpeechToText}, false},
+		{"gpt-4o-transcribe", TypeSpeech, []string{CapAudio, CapSpeechToText}, false},
+		{"gpt-4o-mini-transcribe", TypeSpeech, []string{CapAudio, CapSpeechToText}, false},
+		{"openai/whisper-large-v3", TypeSpeech, []string{CapAudio, CapSpeechToText}, false},
+		{"whisper-1:free", TypeSpeech, []string{CapAudio, CapSpeechToText}, false},
+		{"tts-1", TypeTTS, []string{CapAudio, CapTextToSpeech}, false},
+		{"tts-1-hd", TypeTTS, []string{CapAudio, CapTextToSpeech}, false},
+		{"gpt-4o-mini-tts", TypeTTS, []string{CapAudio, CapTextToSpeech}, false},
+		{"gpt-4o-realtime-preview", TypeRealtime, []string{CapAudio, CapChat, CapRealtime}, true},
+		{"gpt-4o-mini-realtime-preview-2024-12-17", TypeRealtime, []string{CapAudio, CapChat, CapRealtime}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.modelID, func(t *testing.T) {
+			metadata := mc.ClassifyModel(tt.modelID, "")
+			if metadata.Provider != ProviderOpenAI {
+				t.Errorf("Provider = %q, want %q", metadata.Provider, ProviderOpenAI)
+			}
+			if metadata.Type != tt.wantType {
+				t.Errorf("Type = %q, want %q", metadata.Type, tt.wantType)
+			}
+			if !reflect.DeepEqual(metadata.Capabilities, tt.wantCaps) {
+				t.Errorf("Capabilities = %v, want %v", metadata.Capabilities, tt.wantCaps)
+			}
+			// Only realtime models keep a chat context and count as multimodal
+			if hasContext := metadata.Context > 0; hasContext != tt.wantRealtime {
+				t.Errorf("Context = %d, want a context only for realtime models", metadata.Context)
+			}
+			if metadata.IsMultimodal != tt.wantRealtime {
+				t.Errorf("IsMultimodal = %v, want %v", metadata.IsMultimodal, tt.wantRealtime)
+			}
+		})
+	}
+}
+
+func TestTuningDetection(t *testing.T) {
+	mc := NewModelClassifier()
+
+	tests := []struct {
+		modelID    string
+		wantTuning string
+		wantChat   bool
+	}{
+		{"llama-3.1-70b", TuningBase, false},
+		{"llama-3.1-70b-instruct", TuningInstruct, true},
+		{"meta-llama/Llama-3.1-70B-Instruct", TuningInstruct, true},
+		{"llama-2-70b-chat", TuningChat, true},
+		// Hosted API models have no separately published checkpoints
+		{"gpt-4o", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.modelID, func(t *testing.T) {
+			metadata := mc.ClassifyModel(tt.modelID, "")
+			if metadata.Tuning != tt.wantTuning {
+				t.Errorf("Tuning = %q, want %q", metadata.Tuning, tt.wantTuning)
+			}
+			if got := slices.Contains(metadata.Capabilities, CapChat); got != tt.wantChat {
+				t.Errorf("chat capability = %v, want %v (capabilities %v)", got, tt.wantChat, metadata.Capabilities)
+			}
+		})
+	}
+}
+
+func TestMistralLineup(t *testing.T) {
+	mc := NewModelClassifier()
+
+	tests := []struct {
+		modelID     string
+		wantType    string
+		wantVariant string
+		wantCode    bool
+	}{
+		{"mistral-large-2411", "Large", "Mistral Large 2", false},
+		{"mistral-large-latest", "Large", "Mistral Large 2", false},
+		{"mistral-medium", "Medium", "Mistral Medium", false},
+		{"mistral-small-latest", "Small", "Mistral Small", false},
+		{"mistral-tiny", "Tiny", "Mistral Tiny", false},
+		{"open-mistral-7b", "Standard", "Mistral 7B", false},
+		{"mixtral-8x7b-instruct", "Mixtral", "Mixtral 8x7B", false},
+		{"mixtral-8x22b", "Mixtral", "Mixtral 8x22B", false},
+		{"codestral-latest", "Codestral", "Codestral", true},
+		{"codestral-2405", "Codestral", "Codestral", true},
+		{"ministral-3b", "Ministral", "Ministral 3B", false},
+		{"ministral-8b-latest", "Ministral", "Ministral 8B", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.modelID, func(t *testing.T) {
+			metadata := mc.ClassifyModel(tt.modelID, "")
+			if metadata.Provider != ProviderMistral {
+				t.Errorf("Provider = %q, want %q", metadata.Provider, ProviderMistral)
+			}
+			if metadata.Series != "Mistral" {
+				t.Errorf("Series = %q, want %q", metadata.Series, "Mistral")
+			}
+			if metadata.Type != tt.wantType {
+				t.Errorf("Type = %q, want %q", metadata.Type, tt.wantType)
+			}
+			if metadata.Variant != tt.wantVariant {
+				t.Errorf("Variant = %q, want %q", metadata.Variant, tt.wantVariant)
+			}
+			if got := slices.Contains(metadata.Capabilities, CapCode); got != tt.wantCode {
+				t.Errorf("code capability = %v, want %v (capabilities %v)", got, tt.wantCode, metadata.Capabilities)
+			}
+		})
+	}
+}
+
+func TestExperimentalOverrides(t *testing.T) {
+	heuristic := NewModelClassifier()
+	mc := NewModelClassifier(WithExperimentalOverrides(
+		[]string{"gpt-4-turbo-preview", " Gemini-1.5-Pro-Preview "},
+		[]string{"gpt-4o", "o3-mini", "gemini-1.5-pro-preview"},
+	))
+
+	tests := []struct {
+		modelID string
+		want    bool
+	}{
+		// Long-lived "preview" models forced stable
+		{"gpt-4-turbo-preview", false},
+		{"openai/GPT-4-Turbo-Preview", false},
+		// Stable-named models forced experimental
+		{"gpt-4o", true},
+		{"openrouter/openai/o3-mini", true},
+		// Listed as both: experimental wins
+		{"gemini-1.5-pro-preview", true},
+		// Not listed: the heuristic decides
+		{"gpt-4-vision-preview", true},
+		{"claude-3-5-sonnet-20241022", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.modelID, func(t *testing.T) {
+			if got := mc.ClassifyModel(tt.modelID, "").IsExperimental; got != tt.want {
+				t.Errorf("IsExperimental = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// The overridden models disagree with the heuristic, so the override is what won
+	if !heuristic.ClassifyModel("gpt-4-turbo-preview", "").IsExperimental {
+		t.Error("heuristic no longer flags gpt-4-turbo-preview; the stable override is untested")
+	}
+	if heuristic.ClassifyModel("gpt-4o", "").IsExperimental {
+		t.Error("heuristic flags gpt-4o; the experimental override is untested")
+	}
+}
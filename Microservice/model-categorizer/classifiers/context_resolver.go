@@ -1,11 +1,33 @@
 package classifiers
 
-import "strings"
+import (
+	"sort"
+	"strings"
+)
+
+// DefaultContextSize is assumed for chat models of unknown providers when neither the
+// table nor the family heuristics recognize them
+const DefaultContextSize = 8192
+
+// providerDefaultContextSizes are fallbacks for unrecognized models of known providers,
+// matching the window of each provider's current mainstream models (Llama 3.1 and later,
+// Mistral Large 2 and the current Small and Ministral models all accept 128K)
+var providerDefaultContextSizes = map[string]int{
+	ProviderOpenAI:     128000,
+	ProviderAnthropicA: 200000,
+	ProviderGoogle:     1000000,
+	ProviderMeta:       128000,
+	ProviderMistral:    128000,
+}
 
-// ContextResolver handles determining the context window size for models
+// ContextResolver handles determining the context window size for models.
+// The size table is read-only after construction, so it is safe for concurrent use.
 type ContextResolver struct {
 	// Map of known context sizes for specific models
 	contextSizes map[string]int
+
+	// Keys of contextSizes, longest (most specific) first
+	contextOrder []string
 }
 
 // NewContextResolver creates a new context window size resolver
@@ -24,6 +46,7 @@ func NewContextResolver() *ContextResolver {
 		"gpt-3.5-turbo":     4096,
 		"o1":                32768,
 		"o1-mini":           32768,
+		"gpt-oss":           131072,
 
 		// Claude
 		"claude-3-opus":     200000,
@@ -41,10 +64,37 @@ func NewContextResolver() *ContextResolver {
 		"gemini-2.0-pro":        2000000,
 		"gemini-2.0-flash":      1000000,
 		"gemini-2.0-flash-lite": 1000000,
+
+		// Meta; Llama 3.1 and later fall back to the provider default
+		"llama-2":          4096,
+		"llama-3-":         8192,
+		"llama3:":          8192,
+		"llama-4-scout":    10000000,
+		"llama-4-maverick": 1000000,
+
+		// Mistral; current API models fall back to the provider default
+		"mistral-7b":     32768,
+		"mistral-tiny":   32768,
+		"mixtral-8x7b":   32768,
+		"mixtral-8x22b":  65536,
+		"codestral-2405": 32768,
+		"codestral":      256000,
 	}
 
+	contextOrder := make([]string, 0, len(contextSizes))
+	for model := range contextSizes {
+		contextOrder = append(contextOrder, model)
+	}
+	sort.Slice(contextOrder, func(i, j int) bool {
+		if len(contextOrder[i]) != len(contextOrder[j]) {
+			return len(contextOrder[i]) > len(contextOrder[j])
+		}
+		return contextOrder[i] < contextOrder[j]
+	})
+
 	return &ContextResolver{
 		contextSizes: contextSizes,
+		contextOrder: contextOrder,
 	}
 }
 
@@ -52,10 +102,10 @@ func NewContextResolver() *ContextResolver {
 func (cr *ContextResolver) GetContextSize(modelID string) int {
 	modelLower := strings.ToLower(modelID)
 
-	// Check for exact matches first
-	for model, size := range cr.contextSizes {
+	// Check for known names first, most specific first so "gpt-4-vision" wins over "gpt-4"
+	for _, model := range cr.contextOrder {
 		if strings.Contains(modelLower, model) {
-			return size
+			return cr.contextSizes[model]
 		}
 	}
 
@@ -63,6 +113,18 @@ func (cr *ContextResolver) GetContextSize(modelID string) int {
 	return cr.getContextSizeByFamily(modelLower)
 }
 
+// GetContextSizeForProvider is GetContextSize with a fallback for unrecognized models:
+// the provider's default window, or DefaultContextSize for unknown providers
+func (cr *ContextResolver) GetContextSizeForProvider(modelID, provider string) int {
+	if size := cr.GetContextSize(modelID); size > 0 {
+		return size
+	}
+	if size, ok := providerDefaultContextSizes[provider]; ok {
+		return size
+	}
+	return DefaultContextSize
+}
+
 // getContextSizeByFamily uses heuristics to determine context size for common model families
 func (cr *ContextResolver) getContextSizeByFamily(modelLower string) int {
 	// GPT model families
@@ -115,6 +177,18 @@ func (cr *ContextResolver) getContextSizeByFamily(modelLower string) int {
 		return 1000000 // Default for Gemini 1.5/2.0
 	}
 
+	// Gemma model families
+	if strings.Contains(modelLower, "gemma-3") {
+		if strings.Contains(modelLower, "-1b") {
+			return 32768
+		}
+		return 131072
+	}
+
+	if strings.Contains(modelLower, "gemma") {
+		return 8192
+	}
+
 	// Default if no match
 	return 0
 }
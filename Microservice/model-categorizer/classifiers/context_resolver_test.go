@@ -0,0 +1,41 @@
+package classifiers
+
+import "testing"
+
+func TestGetContextSizeForProvider(t *testing.T) {
+	cr := NewContextResolver()
+
+	tests := []struct {
+		modelID  string
+		provider string
+		want     int
+	}{
+		// Unrecognized models of known providers get the provider's mainstream window
+		{"llama-3.3-70b-instruct", ProviderMeta, 128000},
+		{"llama-guard-4", ProviderMeta, 128000},
+		{"mistral-large-latest", ProviderMistral, 128000},
+		{"mistral-saba", ProviderMistral, 128000},
+		{"gpt-5-experimental", ProviderOpenAI, 128000},
+		{"claude-next", ProviderAnthropicA, 200000},
+		// Older open models keep their shorter windows
+		{"llama-2-70b-chat", ProviderMeta, 4096},
+		{"llama-3-8b-instruct", ProviderMeta, 8192},
+		{"llama3:8b-instruct-q4_K_M", ProviderMeta, 8192},
+		{"open-mistral-7b", ProviderMistral, 32768},
+		{"mixtral-8x22b-instruct", ProviderMistral, 65536},
+		{"codestral-2405", ProviderMistral, 32768},
+		{"codestral-latest", ProviderMistral, 256000},
+		{"meta-llama/llama-4-scout", ProviderMeta, 10000000},
+		// Providers without a default fall back to the global default
+		{"command-r", ProviderOther, DefaultContextSize},
+		{"some-model", "", DefaultContextSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.modelID, func(t *testing.T) {
+			if got := cr.GetContextSizeForProvider(tt.modelID, tt.provider); got != tt.want {
+				t.Errorf("GetContextSizeForProvider(%q, %q) = %d, want %d", tt.modelID, tt.provider, got, tt.want)
+			}
+		})
+	}
+}
@@ -4,7 +4,7 @@ import "strings"
 
 // DefaultModels handles detection of default model configurations
 type DefaultModels struct {
-	// Map of known default models
+	// Map of known default models, keyed by lowercase ID
 	defaultModels map[string]bool
 }
 
@@ -27,20 +27,7 @@ func NewDefaultModels() *DefaultModels {
 	}
 }
 
-// IsDefaultModel checks if a model is a default version
+// IsDefaultModel checks if a model is a default version (case insensitive)
 func (dm *DefaultModels) IsDefaultModel(modelID string) bool {
-	// Check direct match in default models map
-	if dm.defaultModels[modelID] {
-		return true
-	}
-
-	// Check for normalized version (case insensitive)
-	modelLower := strings.ToLower(modelID)
-	for model := range dm.defaultModels {
-		if modelLower == strings.ToLower(model) {
-			return true
-		}
-	}
-
-	return false
+	return dm.defaultModels[strings.ToLower(modelID)]
 }
@@ -0,0 +1,43 @@
+package classifiers
+
+import "testing"
+
+func TestIsDefaultModelName(t *testing.T) {
+	mc := NewModelClassifier()
+
+	tests := []struct {
+		modelID string
+		want    bool
+	}{
+		{"gpt-4o", true},
+		{"GPT-4O", true},
+		{"OpenAI/GPT-4O", true},
+		{"openai/gpt-4o:free", true},
+		{"anthropic/Claude-3-Opus", true},
+		{"mistral-large-latest", true},
+		{"gpt-4o-mini", false},
+		{"openai/gpt-4o-2024-08-06", false},
+		// Only known provider prefixes are stripped
+		{"acme/gpt-4o", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.modelID, func(t *testing.T) {
+			if got := mc.IsDefaultModelName(tt.modelID); got != tt.want {
+				t.Errorf("IsDefaultModelName(%q) = %v, want %v", tt.modelID, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultModelsIsDefaultModel(t *testing.T) {
+	defaults := NewDefaultModels()
+	for _, id := range []string{"gpt-4o", "GPT-4o", "Gemini-1.5-Pro"} {
+		if !defaults.IsDefaultModel(id) {
+			t.Errorf("IsDefaultModel(%q) = false, want true", id)
+		}
+	}
+	if defaults.IsDefaultModel("openai/gpt-4o") {
+		t.Error("IsDefaultModel matched a provider-prefixed ID; prefixes are stripped by IsDefaultModelName")
+	}
+}
@@ -0,0 +1,127 @@
+package classifiers
+
+import (
+	"regexp"
+	"strings"
+)
+
+// displayTierTypes are the tier types that read naturally after a variant
+// ("Claude 3.5" + "Sonnet"); other types such as "GPT 4" only repeat the variant
+var displayTierTypes = map[string]bool{
+	TypeOpus:      true,
+	TypeSonnet:    true,
+	TypeHaiku:     true,
+	TypePro:       true,
+	TypeFlash:     true,
+	TypeFlashLite: true,
+}
+
+// hyphenatedBrands are name tokens joined to the following token with a hyphen ("GPT-4", "DALL-E")
+var hyphenatedBrands = map[string]string{
+	"gpt":  "GPT",
+	"dall": "DALL",
+}
+
+// displayAcronyms are name tokens written in capitals
+var displayAcronyms = map[string]bool{
+	"tts": true,
+	"hd":  true,
+	"oss": true,
+	"vl":  true,
+	"r1":  true,
+}
+
+// displaySizePattern matches parameter-size tokens such as "70b" or "8x7b"
+var displaySizePattern = regexp.MustCompile(`^(\d+x)?\d+(\.\d+)?[bm]$`)
+
+// PrettyDisplayName classifies a model and returns a human label for it, such as
+// "GPT-4o (2024-08-06)" for "gpt-4o-2024-08-06"
+func (mc *ModelClassifier) PrettyDisplayName(modelID, provider string) string {
+	return FormatDisplayName(modelID, mc.ClassifyModel(modelID, provider))
+}
+
+// FormatDisplayName builds a human label for a model from its classification metadata.
+// Recognized models are named by variant and tier plus any snapshot or preview date;
+// other models get a cleaned-up version of their ID.
+func FormatDisplayName(modelID string, metadata ModelMetadata) string {
+	if metadata.DisplayName != "" {
+		return metadata.DisplayName
+	}
+
+	baseID, _ := SplitRoutingVariant(modelID)
+	baseID, _ = ExtractQuantization(baseID)
+	if idx := strings.LastIndex(baseID, "/"); idx >= 0 {
+		baseID = baseID[idx+1:]
+	}
+	alias, date := splitSnapshot(baseID)
+
+	// Generic variants (the series fallback, or kinds like "Embedding") say less than the ID
+	var label string
+	if metadata.Provider != ProviderOther && metadata.Variant != "" &&
+		metadata.Variant != metadata.Series && metadata.Variant != metadata.Type {
+		label = metadata.Variant
+		if displayTierTypes[metadata.Type] && !strings.Contains(strings.ToLower(label), strings.ToLower(metadata.Type)) {
+			label += " " + metadata.Type
+		}
+		// Only name the tuning when the ID does ("-instruct", "-it", "-chat")
+		if (metadata.Tuning == TuningInstruct && instructTuningPattern.MatchString(strings.ToLower(alias)) ||
+			metadata.Tuning == TuningChat && chatTuningPattern.MatchString(strings.ToLower(alias))) &&
+			!strings.Contains(strings.ToLower(label), metadata.Tuning) {
+			label += " " + humanizeToken(metadata.Tuning)
+		}
+	} else {
+		label = humanizeModelID(alias)
+	}
+
+	switch {
+	case date != "" && metadata.Provider != ProviderAnthropicA:
+		// Anthropic only publishes dated IDs, so the date would appear on every Claude label
+		label += " (" + formatSnapshotDate(date) + ")"
+	case metadata.PreviewDate != "":
+		label += " (preview " + metadata.PreviewDate + ")"
+	}
+
+	return label
+}
+
+// humanizeModelID turns a model ID such as "text-embedding-3-small" into "Text Embedding 3 Small"
+func humanizeModelID(modelID string) string {
+	tokens := strings.FieldsFunc(strings.ToLower(modelID), func(r rune) bool {
+		return r == '-' || r == '_' || r == ' '
+	})
+
+	words := make([]string, 0, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		if brand, ok := hyphenatedBrands[token]; ok && i+1 < len(tokens) {
+			words = append(words, brand+"-"+humanizeToken(tokens[i+1]))
+			i++
+			continue
+		}
+		words = append(words, humanizeToken(token))
+	}
+	return strings.Join(words, " ")
+}
+
+// humanizeToken capitalizes a single ID token
+func humanizeToken(token string) string {
+	switch {
+	case displayAcronyms[token] || len(token) == 1:
+		return strings.ToUpper(token)
+	case displaySizePattern.MatchString(token):
+		return token[:len(token)-1] + strings.ToUpper(token[len(token)-1:])
+	case token[0] >= '0' && token[0] <= '9':
+		return token
+	default:
+		return strings.ToUpper(token[:1]) + token[1:]
+	}
+}
+
+// formatSnapshotDate writes compact "20241022" dates as "2024-10-22"; other
+// date forms such as "2024-08-06" or "0613" are returned unchanged
+func formatSnapshotDate(date string) string {
+	if len(date) == 8 && !strings.Contains(date, "-") {
+		return date[:4] + "-" + date[4:6] + "-" + date[6:]
+	}
+	return date
+}
@@ -0,0 +1,150 @@
+package classifiers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Run "go test ./classifiers -run TestGolden -update" to rewrite the golden file
+// after an intended classification change
+var update = flag.Bool("update", false, "rewrite testdata/golden.json from the current classifier")
+
+var (
+	goldenFixturesPath = filepath.Join("testdata", "golden_models.txt")
+	goldenPath         = filepath.Join("testdata", "golden.json")
+)
+
+// goldenEntry is the part of a classification the golden file pins down
+type goldenEntry struct {
+	ID           string `json:"id"`
+	ProviderHint string `json:"provider_hint,omitempty"`
+	Provider     string `json:"provider"`
+	Family       string `json:"family,omitempty"`
+	Type         string `json:"type,omitempty"`
+	Variant      string `json:"variant,omitempty"`
+	ContextSize  int    `json:"context_size,omitempty"`
+}
+
+// TestGolden classifies a fixed list of model IDs and compares the result against a
+// checked-in golden file, so pattern edits can't silently change output
+func TestGolden(t *testing.T) {
+	fixtures, err := loadGoldenFixtures(goldenFixturesPath)
+	if err != nil {
+		t.Fatalf("load %s: %v", goldenFixturesPath, err)
+	}
+
+	actual := classifyGoldenFixtures(NewModelClassifier(), fixtures)
+	encoded, err := json.MarshalIndent(actual, "", "  ")
+	if err != nil {
+		t.Fatalf("encode classifications: %v", err)
+	}
+	encoded = append(encoded, '\n')
+
+	if *update {
+		if err := os.WriteFile(goldenPath, encoded, 0o644); err != nil {
+			t.Fatalf("write %s: %v", goldenPath, err)
+		}
+		t.Logf("wrote %d entries to %s", len(actual), goldenPath)
+		return
+	}
+
+	data, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("read %s: %v (run with -update to create it)", goldenPath, err)
+	}
+	if bytes.Equal(data, encoded) {
+		return
+	}
+
+	var expected []goldenEntry
+	if err := json.Unmarshal(data, &expected); err != nil {
+		t.Fatalf("decode %s: %v", goldenPath, err)
+	}
+	for _, diff := range diffGoldenEntries(expected, actual) {
+		t.Error(diff)
+	}
+	t.Errorf("classifications differ from %s; run with -update if the change is intended", goldenPath)
+}
+
+// classifyGoldenFixtures classifies each fixture with its provider hint
+func classifyGoldenFixtures(classifier *ModelClassifier, fixtures []goldenEntry) []goldenEntry {
+	actual := make([]goldenEntry, 0, len(fixtures))
+	for _, fixture := range fixtures {
+		metadata := classifier.ClassifyModel(fixture.ID, fixture.ProviderHint)
+		actual = append(actual, goldenEntry{
+			ID:           fixture.ID,
+			ProviderHint: fixture.ProviderHint,
+			Provider:     metadata.Provider,
+			Family:       metadata.Family,
+			Type:         metadata.Type,
+			Variant:      metadata.Variant,
+			ContextSize:  metadata.Context,
+		})
+	}
+	return actual
+}
+
+// loadGoldenFixtures reads "<id> [provider hint]" lines, skipping blanks and # comments
+func loadGoldenFixtures(path string) ([]goldenEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var fixtures []goldenEntry
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) > 2 {
+			return nil, fmt.Errorf("line %d: expected \"<id> [provider]\", got %q", line, text)
+		}
+		fixture := goldenEntry{ID: fields[0]}
+		if len(fields) == 2 {
+			fixture.ProviderHint = fields[1]
+		}
+		fixtures = append(fixtures, fixture)
+	}
+	return fixtures, scanner.Err()
+}
+
+// diffGoldenEntries describes entries that changed, appeared or disappeared, keyed by ID and hint
+func diffGoldenEntries(expected, actual []goldenEntry) []string {
+	key := func(entry goldenEntry) string { return entry.ID + "|" + entry.ProviderHint }
+
+	expectedByKey := make(map[string]goldenEntry, len(expected))
+	for _, entry := range expected {
+		expectedByKey[key(entry)] = entry
+	}
+
+	var diffs []string
+	seen := make(map[string]bool, len(actual))
+	for _, entry := range actual {
+		seen[key(entry)] = true
+		want, ok := expectedByKey[key(entry)]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("+ %s: not in golden file", entry.ID))
+			continue
+		}
+		if want != entry {
+			diffs = append(diffs, fmt.Sprintf("~ %s:\n    want %+v\n    got  %+v", entry.ID, want, entry))
+		}
+	}
+	for _, entry := range expected {
+		if !seen[key(entry)] {
+			diffs = append(diffs, fmt.Sprintf("- %s: no longer in fixtures", entry.ID))
+		}
+	}
+	return diffs
+}
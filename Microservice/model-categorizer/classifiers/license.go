@@ -0,0 +1,143 @@
+package classifiers
+
+import "strings"
+
+// License values
+const (
+	LicenseOpen        = "open"
+	LicenseProprietary = "proprietary"
+	LicenseUnknown     = "unknown"
+)
+
+// licenseRule maps a model name pattern to its license
+type licenseRule struct {
+	pattern string
+	license string
+}
+
+// familyLicenses lists model families by license. Order matters: more specific
+// patterns (e.g. "gpt-oss") must come before the broader ones they contain.
+var familyLicenses = []licenseRule{
+	// Open-weight families
+	{"gpt-oss", LicenseOpen},
+	{"llama", LicenseOpen},
+	{"qwen", LicenseOpen},
+	{"gemma", LicenseOpen},
+	{"phi-", LicenseOpen},
+	{"deepseek", LicenseOpen},
+	{"falcon", LicenseOpen},
+	{"stable-diffusion", LicenseOpen},
+	{"sdxl", LicenseOpen},
+	{"sd3", LicenseOpen},
+
+	// Proprietary families
+	{"gpt", LicenseProprietary},
+	{"o1", LicenseProprietary},
+	{"o3", LicenseProprietary},
+	{"dall-e", LicenseProprietary},
+	{"claude", LicenseProprietary},
+	{"gemini", LicenseProprietary},
+	{"imagen", LicenseProprietary},
+	{"stable-image", LicenseProprietary}, // Stability's hosted API models; no weights are released
+	{"command", LicenseProprietary},
+}
+
+// ownerLicenses maps OpenRouter/HuggingFace owner prefixes (the part before "/")
+// to the license their models are published under
+var ownerLicenses = map[string]string{
+	"meta-llama":  LicenseOpen,
+	"qwen":        LicenseOpen,
+	"deepseek-ai": LicenseOpen,
+	"microsoft":   LicenseOpen,
+	"tiiuae":      LicenseOpen,
+	"openai":      LicenseProprietary,
+	"anthropic":   LicenseProprietary,
+	"cohere":      LicenseProprietary,
+}
+
+// providerLicenses is the fallback license for models identified only by provider.
+// Stability is left out: it releases some weights (the families above) but not all.
+var providerLicenses = map[string]string{
+	ProviderMeta:       LicenseOpen,
+	ProviderOpenAI:     LicenseProprietary,
+	ProviderAnthropicA: LicenseProprietary,
+	ProviderGoogle:     LicenseProprietary,
+}
+
+// mistralFamilies are the name markers of Mistral's model lines
+var mistralFamilies = []string{
+	"mistral", "mixtral", "codestral", "ministral", "pixtral", "magistral", "devstral", "mathstral", "voxtral",
+}
+
+// mistralReleasedWeights lists the Mistral models published under an open license.
+// Mistral also serves API-only models (Large, Medium, Codestral, Ministral), so a
+// Mistral model is proprietary unless it matches one of these.
+var mistralReleasedWeights = []string{
+	"mistral-7b",
+	"mistral-tiny", // Mistral 7B on the API
+	"open-mistral",
+	"mistral-nemo",
+	"mistral-small",
+	"mixtral",
+	"codestral-mamba",
+	"mathstral",
+	"pixtral-12b",
+	"magistral-small",
+	"devstral-small",
+	"voxtral",
+}
+
+// isMistralModel reports whether a model belongs to one of Mistral's model lines
+func isMistralModel(name, owner, provider string) bool {
+	if owner == "mistralai" || provider == ProviderMistral {
+		return true
+	}
+	for _, family := range mistralFamilies {
+		if strings.Contains(name, family) {
+			return true
+		}
+	}
+	return false
+}
+
+// mistralLicense returns the license of a Mistral model from the released-weights list
+func mistralLicense(name string) string {
+	for _, pattern := range mistralReleasedWeights {
+		if strings.Contains(name, pattern) {
+			return LicenseOpen
+		}
+	}
+	return LicenseProprietary
+}
+
+// determineLicense infers whether a model is open-weight or proprietary from its
+// family name, then its owner prefix, then its provider. Mistral models are checked
+// against the released-weights list first, since Mistral publishes only some of them.
+func determineLicense(modelName, provider string) string {
+	modelLower := strings.ToLower(modelName)
+
+	owner, name := "", modelLower
+	if idx := strings.Index(modelLower, "/"); idx >= 0 {
+		owner, name = modelLower[:idx], modelLower[idx+1:]
+	}
+
+	if isMistralModel(name, owner, provider) {
+		return mistralLicense(name)
+	}
+
+	for _, rule := range familyLicenses {
+		if strings.Contains(name, rule.pattern) {
+			return rule.license
+		}
+	}
+
+	if license, ok := ownerLicenses[owner]; ok {
+		return license
+	}
+
+	if license, ok := providerLicenses[provider]; ok {
+		return license
+	}
+
+	return LicenseUnknown
+}
@@ -0,0 +1,85 @@
+package classifiers
+
+import "testing"
+
+func TestDetermineLicense(t *testing.T) {
+	tests := []struct {
+		modelName string
+		provider  string
+		want      string
+	}{
+		// Families named by the model ID
+		{"llama-3.1-70b-instruct", ProviderMeta, LicenseOpen},
+		{"gemma-2-9b-it", ProviderGoogle, LicenseOpen},
+		{"gpt-oss-120b", ProviderOpenAI, LicenseOpen},
+		{"gpt-4o", ProviderOpenAI, LicenseProprietary},
+		{"claude-3-5-sonnet-20241022", ProviderAnthropicA, LicenseProprietary},
+		{"gemini-2.0-flash", ProviderGoogle, LicenseProprietary},
+
+		// Stability releases weights for some families and serves others only by API
+		{"stable-diffusion-xl-1024-v1-0", ProviderStability, LicenseOpen},
+		{"sdxl-turbo", ProviderStability, LicenseOpen},
+		{"sd3-medium", ProviderStability, LicenseOpen},
+		{"sd3.5-large", ProviderStability, LicenseOpen},
+		{"stable-image-core", ProviderStability, LicenseProprietary},
+		{"stable-image-ultra", ProviderStability, LicenseProprietary},
+		{"stable-audio-2", ProviderStability, LicenseUnknown},
+
+		// Mistral releases weights for some models and serves others only by API
+		{"open-mistral-7b", ProviderMistral, LicenseOpen},
+		{"mistral-7b-instruct", ProviderMistral, LicenseOpen},
+		{"mistral-tiny", ProviderMistral, LicenseOpen},
+		{"open-mistral-nemo", ProviderMistral, LicenseOpen},
+		{"mistral-small-latest", ProviderMistral, LicenseOpen},
+		{"open-mixtral-8x22b", ProviderMistral, LicenseOpen},
+		{"mistralai/mixtral-8x7b-instruct", ProviderOther, LicenseOpen},
+		{"pixtral-12b-2409", ProviderMistral, LicenseOpen},
+		{"open-codestral-mamba", ProviderMistral, LicenseOpen},
+		{"mistral-large-2411", ProviderMistral, LicenseProprietary},
+		{"mistral-large-latest", "", LicenseProprietary},
+		{"mistralai/mistral-large", ProviderOther, LicenseProprietary},
+		{"mistral-medium", ProviderMistral, LicenseProprietary},
+		{"codestral-latest", ProviderMistral, LicenseProprietary},
+		{"codestral-2405", "", LicenseProprietary},
+		{"ministral-8b-latest", ProviderMistral, LicenseProprietary},
+		{"pixtral-large-latest", ProviderMistral, LicenseProprietary},
+		{"mistral-new", ProviderMistral, LicenseProprietary},
+
+		// Owner prefixes and provider fallbacks
+		{"meta-llama/some-new-model", ProviderOther, LicenseOpen},
+		{"anthropic/some-new-model", ProviderOther, LicenseProprietary},
+		{"unknown-model", ProviderOther, LicenseUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.modelName, func(t *testing.T) {
+			if got := determineLicense(tt.modelName, tt.provider); got != tt.want {
+				t.Errorf("determineLicense(%q, %q) = %q, want %q", tt.modelName, tt.provider, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifyModelLicense(t *testing.T) {
+	mc := NewModelClassifier()
+
+	for _, tt := range []struct {
+		modelID     string
+		wantOpen    bool
+		wantLicense string
+	}{
+		{"meta-llama/llama-3.1-8b-instruct", true, LicenseOpen},
+		{"gpt-4o", false, LicenseProprietary},
+		{"stable-image-core", false, LicenseProprietary},
+		{"stabilityai/stable-diffusion-3.5-large", true, LicenseOpen},
+		{"open-mixtral-8x7b", true, LicenseOpen},
+		{"mistral-large-2411", false, LicenseProprietary},
+		{"codestral-latest", false, LicenseProprietary},
+	} {
+		metadata := mc.ClassifyModel(tt.modelID, "")
+		if metadata.License != tt.wantLicense || metadata.IsOpenWeight != tt.wantOpen {
+			t.Errorf("ClassifyModel(%q) license = %q open = %v, want %q open = %v",
+				tt.modelID, metadata.License, metadata.IsOpenWeight, tt.wantLicense, tt.wantOpen)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package classifiers
+
+import "strings"
+
+// Option configures a ModelClassifier
+type Option func(*ModelClassifier)
+
+// WithExperimentalOverrides forces the listed model IDs to be reported as stable or
+// experimental regardless of the name-based heuristic. IDs are matched case-insensitively,
+// with or without a "provider/" prefix; a model in both lists is reported experimental.
+func WithExperimentalOverrides(stable, experimental []string) Option {
+	return func(mc *ModelClassifier) {
+		for _, id := range stable {
+			if id = strings.ToLower(strings.TrimSpace(id)); id != "" {
+				mc.experimentalOverrides[id] = false
+			}
+		}
+		for _, id := range experimental {
+			if id = strings.ToLower(strings.TrimSpace(id)); id != "" {
+				mc.experimentalOverrides[id] = true
+			}
+		}
+	}
+}
+
+// WithExperimentalKeywords replaces DefaultExperimentalKeywords with the given name
+// markers. Keywords match whole name tokens, optionally followed by digits ("rc" matches
+// "-rc1"). An empty list keeps the defaults.
+func WithExperimentalKeywords(keywords []string) Option {
+	return func(mc *ModelClassifier) {
+		if pattern := experimentalKeywordPattern(keywords); pattern != nil {
+			mc.experimentalPattern = pattern
+		}
+	}
+}
+
+// WithPatterns adds the pattern file's provider, series and type patterns to the
+// built-in tables. Longer patterns still match first, whichever table they came from.
+func WithPatterns(patterns PatternFile) Option {
+	return func(mc *ModelClassifier) {
+		mc.patterns = mc.patterns.withPatterns(patterns)
+	}
+}
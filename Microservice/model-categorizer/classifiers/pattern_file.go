@@ -0,0 +1,101 @@
+package classifiers
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+// PatternFile holds name patterns added to the built-in provider, series and type
+// tables, keyed by the value a match reports (e.g. a provider id). Patterns are matched
+// as lowercase substrings of the model name, like the built-in ones.
+type PatternFile struct {
+	Providers map[string][]string `json:"providers,omitempty"`
+	Series    map[string][]string `json:"series,omitempty"`
+	Types     map[string][]string `json:"types,omitempty"`
+}
+
+// LoadPatternFile reads a JSON pattern file such as
+//
+//	{"providers": {"xai": ["grok"]}, "types": {"Mini": ["-lite"]}}
+//
+// An empty path means no extra patterns.
+func LoadPatternFile(path string) (PatternFile, error) {
+	var patterns PatternFile
+	if path == "" {
+		return patterns, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return patterns, err
+	}
+	if err := json.Unmarshal(data, &patterns); err != nil {
+		return patterns, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	tables := map[string]map[string][]string{
+		"providers": patterns.Providers,
+		"series":    patterns.Series,
+		"types":     patterns.Types,
+	}
+	for table, entries := range tables {
+		for key, list := range entries {
+			if strings.TrimSpace(key) == "" {
+				return patterns, fmt.Errorf("parse %s: %s has an empty key", path, table)
+			}
+			for i, pattern := range list {
+				if pattern = strings.ToLower(strings.TrimSpace(pattern)); pattern == "" {
+					return patterns, fmt.Errorf("parse %s: %s %q has an empty pattern", path, table, key)
+				}
+				list[i] = pattern
+			}
+		}
+	}
+	return patterns, nil
+}
+
+// PatternCount returns the number of patterns in the file
+func (p PatternFile) PatternCount() int {
+	count := 0
+	for _, table := range []map[string][]string{p.Providers, p.Series, p.Types} {
+		for _, patterns := range table {
+			count += len(patterns)
+		}
+	}
+	return count
+}
+
+// withPatterns returns a copy of the matcher with the file's patterns added. The
+// receiver is left untouched, so a classifier already serving requests never sees
+// a half-built table.
+func (pm *PatternMatcher) withPatterns(extra PatternFile) *PatternMatcher {
+	merged := *pm
+	merged.providerPatterns = mergePatterns(pm.providerPatterns, extra.Providers)
+	merged.seriesPatterns = mergePatterns(pm.seriesPatterns, extra.Series)
+	merged.typePatterns = mergePatterns(pm.typePatterns, extra.Types)
+	merged.providerOrder = orderPatterns(merged.providerPatterns)
+	merged.seriesOrder = orderPatterns(merged.seriesPatterns)
+	merged.typeOrder = orderPatterns(merged.typePatterns)
+	return &merged
+}
+
+// mergePatterns copies base and appends the extra patterns under their keys,
+// skipping patterns a key already has
+func mergePatterns(base, extra map[string][]string) map[string][]string {
+	merged := make(map[string][]string, len(base)+len(extra))
+	for key, patterns := range base {
+		merged[key] = append([]string(nil), patterns...)
+	}
+
+	for key, patterns := range extra {
+		for _, pattern := range patterns {
+			if !slices.Contains(merged[key], pattern) {
+				merged[key] = append(merged[key], pattern)
+			}
+		}
+	}
+	return merged
+}
@@ -0,0 +1,65 @@
+package classifiers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadPatternFile(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantCount int
+		wantErr   bool
+	}{
+		{"providers and types", `{"providers": {"xai": ["Grok"]}, "types": {"Mini": ["-lite", "-small"]}}`, 3, false},
+		{"empty object", `{}`, 0, false},
+		{"invalid JSON", `{"providers": [}`, 0, true},
+		{"empty pattern", `{"series": {"Grok": [" "]}}`, 0, true},
+		{"empty key", `{"providers": {"": ["grok"]}}`, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "patterns.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			patterns, err := LoadPatternFile(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadPatternFile error = %v, want error %v", err, tt.wantErr)
+			}
+			if err == nil && patterns.PatternCount() != tt.wantCount {
+				t.Errorf("PatternCount() = %d, want %d", patterns.PatternCount(), tt.wantCount)
+			}
+		})
+	}
+
+	if patterns, err := LoadPatternFile(""); err != nil || patterns.PatternCount() != 0 {
+		t.Errorf(`LoadPatternFile("") = %+v, %v; want no patterns and no error`, patterns, err)
+	}
+}
+
+func TestWithPatterns(t *testing.T) {
+	base := NewModelClassifier()
+	extended := NewModelClassifier(WithPatterns(PatternFile{
+		Providers: map[string][]string{"xai": {"grok"}},
+	}))
+
+	if got := base.ClassifyModel("grok-2", "").Provider; got != ProviderOther {
+		t.Fatalf("without the pattern file: provider = %q, want %q", got, ProviderOther)
+	}
+	if got := extended.ClassifyModel("grok-2", "").Provider; got != "xai" {
+		t.Errorf("with the pattern file: provider = %q, want %q", got, "xai")
+	}
+	if got, want := extended.PatternCount(), base.PatternCount()+1; got != want {
+		t.Errorf("PatternCount() = %d, want %d", got, want)
+	}
+
+	// Built-in patterns are unchanged for other classifiers
+	if got := NewModelClassifier().ClassifyModel("grok-2", "").Provider; got != ProviderOther {
+		t.Errorf("a new classifier picked up the extra pattern: provider = %q", got)
+	}
+}
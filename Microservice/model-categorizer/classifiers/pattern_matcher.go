@@ -1,8 +1,13 @@
 package classifiers
 
-import "strings"
-
-// PatternMatcher handles all pattern-based identification for models
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// PatternMatcher handles all pattern-based identification for models.
+// Its pattern tables are read-only after construction, so it is safe for concurrent use.
 type PatternMatcher struct {
 	// Provider detection patterns
 	providerPatterns map[string][]string
@@ -15,17 +20,51 @@ type PatternMatcher struct {
 
 	// Capability detection patterns
 	capabilityPatterns map[string][]string
+
+	// Provider, series and type patterns in matching precedence order
+	providerOrder []keyedPattern
+	seriesOrder   []keyedPattern
+	typeOrder     []keyedPattern
+}
+
+// keyedPattern is a name pattern together with the table key it identifies
+type keyedPattern struct {
+	key     string
+	pattern string
+}
+
+// orderPatterns flattens a pattern table into a fixed matching order: longer (more
+// specific) patterns first, ties broken by key and then pattern. Ranging over the map
+// directly would pick a different match between runs when a name matches several keys.
+func orderPatterns(table map[string][]string) []keyedPattern {
+	var ordered []keyedPattern
+	for key, patterns := range table {
+		for _, pattern := range patterns {
+			ordered = append(ordered, keyedPattern{key: key, pattern: pattern})
+		}
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		if len(ordered[i].pattern) != len(ordered[j].pattern) {
+			return len(ordered[i].pattern) > len(ordered[j].pattern)
+		}
+		if ordered[i].key != ordered[j].key {
+			return ordered[i].key < ordered[j].key
+		}
+		return ordered[i].pattern < ordered[j].pattern
+	})
+	return ordered
 }
 
 // NewPatternMatcher creates a new pattern matcher with all patterns
 func NewPatternMatcher() *PatternMatcher {
 	// Initialize provider detection patterns
 	providerPatterns := map[string][]string{
-		ProviderOpenAI:     {"openai", "gpt", "o1", "dall-e"},
+		ProviderOpenAI:     {"openai", "gpt", "o1", "dall-e", "whisper", "tts-1"},
 		ProviderAnthropicA: {"anthropic", "claude"},
-		ProviderGemini:     {"gemini", "google"},
+		ProviderGoogle:     {"gemini", "google", "imagen", "gemma"},
 		ProviderMeta:       {"meta", "llama", "meta-llama"},
-		ProviderMistral:    {"mistral", "mixtral"},
+		ProviderMistral:    {"mistral", "mixtral", "codestral", "ministral", "pixtral"},
+		ProviderStability:  {"stability", "stable-diffusion", "stable-image", "sdxl", "sd3"},
 	}
 
 	// Initialize series detection patterns
@@ -38,7 +77,9 @@ func NewPatternMatcher() *PatternMatcher {
 		"Gemini " + Version20: {"gemini-2.0", "gemini-2.0-pro", "gemini-2.0-flash"},
 		"Gemini " + Version25: {"gemini-2.5", "gemini-2.5-pro", "gemini-2.5-flash"},
 		"Gemma 2":             {"gemma-2"},
-		TypeImage:             {"dall-e", "imagen", "midjourney", "stable-diffusion"},
+		"Gemma 3":             {"gemma-3"},
+		SeriesStableDiffusion: {"stable-diffusion", "stable-image", "sdxl", "sd3"},
+		TypeImage:             {"dall-e", "gpt-image", "imagen", "flux", "midjourney"},
 		TypeEmbedding:         {"embedding", "text-embedding", "embed"},
 	}
 
@@ -57,7 +98,10 @@ func NewPatternMatcher() *PatternMatcher {
 		TypeFlash:     {"flash"},
 		TypeThinking:  {"thinking"},
 		TypeVision:    {"vision", "multimodal"},
-		TypeEmbedding: {"embedding", "embed", "tts"},
+		TypeEmbedding: {"embedding", "embed"},
+		TypeRealtime:  {"realtime"},
+		TypeTTS:       {"tts", "text-to-speech"},
+		TypeSpeech:    {"whisper", "transcribe", "speech-to-text"},
 	}
 
 	// Initialize capability patterns
@@ -65,7 +109,7 @@ func NewPatternMatcher() *PatternMatcher {
 		CapVision:          {"vision", "image", "multimodal"},
 		CapFunctionCalling: {"function", "tool", "api"},
 		CapEmbedding:       {"embedding", "embed", "vector"},
-		"audio":            {"whisper", "tts", "speech", "audio"},
+		CapAudio:           {"whisper", "tts", "speech", "audio"},
 		CapChat:            {"chat", "conversation", "completion"},
 	}
 
@@ -74,6 +118,9 @@ func NewPatternMatcher() *PatternMatcher {
 		seriesPatterns:     seriesPatterns,
 		typePatterns:       typePatterns,
 		capabilityPatterns: capabilityPatterns,
+		providerOrder:      orderPatterns(providerPatterns),
+		seriesOrder:        orderPatterns(seriesPatterns),
+		typeOrder:          orderPatterns(typePatterns),
 	}
 }
 
@@ -87,43 +134,59 @@ func (pm *PatternMatcher) matchProviderByName(providerName string) string {
 	return ""
 }
 
+// PatternCount returns the total number of name patterns across all pattern tables
+func (pm *PatternMatcher) PatternCount() int {
+	count := len(familyPatterns)
+	for _, table := range []map[string][]string{pm.providerPatterns, pm.seriesPatterns, pm.typePatterns, pm.capabilityPatterns} {
+		for _, patterns := range table {
+			count += len(patterns)
+		}
+	}
+	return count
+}
+
 // matchProviderByPattern matches a provider based on patterns
 func (pm *PatternMatcher) matchProviderByPattern(modelName string) string {
-	modelLower := strings.ToLower(modelName)
-	for provider, patterns := range pm.providerPatterns {
-		for _, pattern := range patterns {
-			if strings.Contains(modelLower, pattern) {
-				return provider
-			}
+	provider, _ := pm.matchProviderPattern(modelName)
+	return provider
+}
+
+// matchProviderPattern matches a provider based on patterns, also returning the pattern that matched.
+// The most specific matching pattern wins, so the result does not depend on map order.
+func (pm *PatternMatcher) matchProviderPattern(modelName string) (string, string) {
+	return matchOrdered(pm.providerOrder, strings.ToLower(modelName))
+}
+
+// matchOrdered returns the key and pattern of the first ordered pattern contained in the name
+func matchOrdered(ordered []keyedPattern, modelName string) (string, string) {
+	for _, kp := range ordered {
+		if strings.Contains(modelName, kp.pattern) {
+			return kp.key, kp.pattern
 		}
 	}
-	return ""
+	return "", ""
 }
 
 // matchClaudeVersion matches Claude series version
 func (pm *PatternMatcher) matchClaudeVersion(modelName string) string {
-	modelLower := strings.ToLower(modelName)
-
-	// Check for Claude series versions
-	for _, pattern := range pm.seriesPatterns[SeriesClaude3] {
-		if strings.Contains(modelLower, pattern) {
-			return SeriesClaude3
-		}
-	}
+	series, _ := pm.matchClaudeVersionPattern(modelName)
+	return series
+}
 
-	for _, pattern := range pm.seriesPatterns[SeriesClaude2] {
-		if strings.Contains(modelLower, pattern) {
-			return SeriesClaude2
-		}
-	}
+// matchClaudeVersionPattern matches Claude series version, also returning the pattern that matched
+func (pm *PatternMatcher) matchClaudeVersionPattern(modelName string) (string, string) {
+	modelLower := strings.ToLower(modelName)
 
-	for _, pattern := range pm.seriesPatterns[SeriesClaude1] {
-		if strings.Contains(modelLower, pattern) {
-			return SeriesClaude1
+	// Check for Claude series versions, newest first
+	for _, series := range []string{SeriesClaude3, SeriesClaude2, SeriesClaude1} {
+		for _, pattern := range pm.seriesPatterns[series] {
+			if strings.Contains(modelLower, pattern) {
+				return series, pattern
+			}
 		}
 	}
 
-	return ""
+	return "", ""
 }
 
 // matchGeminiVersion matches Gemini version series
@@ -143,31 +206,185 @@ func (pm *PatternMatcher) matchGeminiVersion(modelName string) string {
 	return "Gemini " + Version10
 }
 
-// matchSeriesByPattern matches model series by patterns
-func (pm *PatternMatcher) matchSeriesByPattern(modelName string) string {
+// gemmaVersionPattern captures the generation and optional parameter size of Gemma models
+var gemmaVersionPattern = regexp.MustCompile(`gemma[-_ ]?(\d+(?:\.\d+)?)?(?:.*?[-_:](\d+(?:\.\d+)?b)\b)?`)
+
+// findGemmaVersion returns the generation (e.g. "2") and upper-cased parameter size
+// (e.g. "27B") of a Gemma model. A first-generation name such as "gemma-7b" has no
+// generation, only a size.
+func findGemmaVersion(modelName string) (version, size string, ok bool) {
 	modelLower := strings.ToLower(modelName)
+	loc := gemmaVersionPattern.FindStringSubmatchIndex(modelLower)
+	if loc == nil {
+		return "", "", false
+	}
+	if loc[2] >= 0 {
+		version = modelLower[loc[2]:loc[3]]
+	}
+	if loc[4] >= 0 {
+		size = modelLower[loc[4]:loc[5]]
+	}
+	if version != "" && loc[3] < len(modelLower) && modelLower[loc[3]] == 'b' {
+		version, size = "", version+"b"
+	}
+	return version, strings.ToUpper(size), true
+}
 
-	for series, patterns := range pm.seriesPatterns {
-		for _, pattern := range patterns {
-			if strings.Contains(modelLower, pattern) {
-				return series
+// matchGemmaVersion matches Gemma generation series (e.g. "Gemma 2")
+func (pm *PatternMatcher) matchGemmaVersion(modelName string) string {
+	version, _, ok := findGemmaVersion(modelName)
+	if !ok {
+		return ""
+	}
+	if version == "" {
+		return "Gemma"
+	}
+	return "Gemma " + version
+}
+
+// buildGemmaVariant builds a Gemma variant string from the series and parameter size
+func (pm *PatternMatcher) buildGemmaVariant(modelName, series string) string {
+	_, size, ok := findGemmaVersion(modelName)
+	if !ok {
+		return ""
+	}
+	if size != "" {
+		return series + " " + size
+	}
+	return series
+}
+
+// llamaVersionPattern captures the version and optional parameter size of Llama models
+var llamaVersionPattern = regexp.MustCompile(`llama[-_ ]?v?(\d+(?:[.p]\d+)?)(?:.*?[-_:](\d+(?:\.\d+)?b)\b)?`)
+
+// findLlamaVersion matches llamaVersionPattern, rejecting a bare parameter size such as
+// "llama-70b" being read as version 70
+func findLlamaVersion(modelName string) []string {
+	modelLower := strings.ToLower(modelName)
+	loc := llamaVersionPattern.FindStringSubmatchIndex(modelLower)
+	if loc == nil || (loc[3] < len(modelLower) && modelLower[loc[3]] == 'b') {
+		return nil
+	}
+	return llamaVersionPattern.FindStringSubmatch(modelLower)
+}
+
+// matchLlamaVersion matches Llama version series (e.g. "Llama 3.1")
+func (pm *PatternMatcher) matchLlamaVersion(modelName string) string {
+	match := findLlamaVersion(modelName)
+	if match == nil {
+		return ""
+	}
+	return "Llama " + strings.Replace(match[1], "p", ".", 1)
+}
+
+// buildLlamaVariant builds Llama variant string from the series and parameter size
+func (pm *PatternMatcher) buildLlamaVariant(modelName, series string) string {
+	match := findLlamaVersion(modelName)
+	if match == nil {
+		return ""
+	}
+	if match[2] != "" {
+		return series + " " + strings.ToUpper(match[2])
+	}
+	return series
+}
+
+// mistralSizePattern captures Mistral parameter sizes such as "7b", "8x7b" or "8x22b"
+var mistralSizePattern = regexp.MustCompile(`(\d+x)?\d+(\.\d+)?b\b`)
+
+// mistralLargeSnapshotPattern captures the YYMM snapshot date of Mistral Large releases
+var mistralLargeSnapshotPattern = regexp.MustCompile(`large-(\d{4})\b`)
+
+// matchMistralType matches Mistral model types
+func (pm *PatternMatcher) matchMistralType(modelName string) string {
+	modelLower := strings.ToLower(modelName)
+
+	switch {
+	case strings.Contains(modelLower, "mixtral"):
+		return TypeMixtral
+	case strings.Contains(modelLower, "codestral"):
+		return TypeCodestral
+	case strings.Contains(modelLower, "ministral"):
+		return TypeMinistral
+	case strings.Contains(modelLower, "large"):
+		return TypeLarge
+	case strings.Contains(modelLower, "medium"):
+		return TypeMedium
+	case strings.Contains(modelLower, "small"):
+		return TypeSmall
+	case strings.Contains(modelLower, "tiny"):
+		return TypeTiny
+	default:
+		return TypeStandard
+	}
+}
+
+// buildMistralVariant builds Mistral variant string (e.g. "Mixtral 8x22B", "Mistral Large 2")
+func (pm *PatternMatcher) buildMistralVariant(modelName string) string {
+	modelLower := strings.ToLower(modelName)
+
+	size := strings.Replace(strings.ToUpper(mistralSizePattern.FindString(modelLower)), "X", "x", 1)
+	withSize := func(name string) string {
+		if size == "" {
+			return name
+		}
+		return name + " " + size
+	}
+
+	switch type_ := pm.matchMistralType(modelLower); type_ {
+	case TypeMixtral:
+		return withSize("Mixtral")
+	case TypeCodestral:
+		return "Codestral"
+	case TypeMinistral:
+		return withSize("Ministral")
+	case TypeLarge:
+		// Large 2 shipped as the 2407 snapshot; "-latest" always points at it or newer
+		if match := mistralLargeSnapshotPattern.FindStringSubmatch(modelLower); match != nil {
+			if match[1] >= "2407" {
+				return "Mistral Large 2"
 			}
+			return "Mistral Large"
 		}
+		if strings.Contains(modelLower, "large-latest") || strings.Contains(modelLower, "large-2") {
+			return "Mistral Large 2"
+		}
+		return "Mistral Large"
+	case TypeMedium, TypeSmall, TypeTiny:
+		return "Mistral " + type_
+	default:
+		if size != "" {
+			return "Mistral " + size
+		}
+		return ""
 	}
+}
 
-	return ""
+// matchSeriesByPattern matches model series by patterns
+func (pm *PatternMatcher) matchSeriesByPattern(modelName string) string {
+	series, _ := pm.matchSeriesPattern(modelName)
+	return series
 }
 
+// matchSeriesPattern matches model series by patterns, also returning the pattern that matched
+func (pm *PatternMatcher) matchSeriesPattern(modelName string) (string, string) {
+	return matchOrdered(pm.seriesOrder, strings.ToLower(modelName))
+}
+
+// oSeriesPattern matches O-series model names such as "o1", "o3-mini" or "openai/o4-mini"
+var oSeriesPattern = regexp.MustCompile(`(^|/)o\d+([-_.:]|$)`)
+
 // matchOpenAIType matches OpenAI model types
 func (pm *PatternMatcher) matchOpenAIType(modelName string) string {
 	modelLower := strings.ToLower(modelName)
 
-	if strings.Contains(modelLower, "mini") {
-		return TypeMini
+	// Checked first so "gpt-oss" never falls through to the GPT-4/O-series rules
+	if strings.Contains(modelLower, "gpt-oss") {
+		return TypeOSS
 	}
 
-	// Handle O series models
-	if strings.Contains(modelLower, "o1") || strings.Contains(modelLower, "o3") {
+	// Handle O series models, including their minis ("o1-mini" is an O-series model)
+	if oSeriesPattern.MatchString(modelLower) {
 		return TypeO
 	}
 
@@ -186,6 +403,11 @@ func (pm *PatternMatcher) matchOpenAIType(modelName string) string {
 		return Type35
 	}
 
+	// Minis of GPT generations matched above ("gpt-4o-mini") stay in that generation
+	if strings.Contains(modelLower, "mini") {
+		return TypeMini
+	}
+
 	return TypeStandard
 }
 
@@ -204,44 +426,86 @@ func (pm *PatternMatcher) matchAnthropicType(modelName string) string {
 	return TypeStandard
 }
 
+// reasoningSuffixPattern matches "-thinking"/"-reasoning" name tokens such as the one in
+// "gemini-2.0-flash-thinking-exp"
+var reasoningSuffixPattern = regexp.MustCompile(`[-_](thinking|reasoning)([-_.:]|$)`)
+
+// stripReasoningSuffix removes reasoning-mode tokens from a model name so the base type
+// (Flash, Pro, Sonnet...) can be determined, reporting whether any were present
+func stripReasoningSuffix(modelName string) (string, bool) {
+	if !reasoningSuffixPattern.MatchString(modelName) {
+		return modelName, false
+	}
+	return reasoningSuffixPattern.ReplaceAllString(modelName, "$2"), true
+}
+
 // matchGeminiType matches Gemini model types
 func (pm *PatternMatcher) matchGeminiType(modelName string) string {
+	// Open-weight Gemma models are never Gemini Flash/Pro tiers
+	if strings.Contains(modelName, "gemma") {
+		return TypeGemma
+	}
 	if strings.Contains(modelName, "flash-lite") || strings.Contains(modelName, "flash lite") {
 		return TypeFlashLite
 	}
-	if strings.Contains(modelName, "thinking") {
-		return TypeThinking
-	}
 	if strings.Contains(modelName, "flash") {
 		return TypeFlash
 	}
 	if strings.Contains(modelName, "pro") {
 		return TypePro
 	}
-	if strings.Contains(modelName, "gemma") {
-		return TypeGemma
-	}
 	return TypeStandard
 }
 
-// matchTypeByPattern matches model type by generic patterns
-func (pm *PatternMatcher) matchTypeByPattern(modelName string) string {
-	for type_, patterns := range pm.typePatterns {
-		for _, pattern := range patterns {
-			if strings.Contains(modelName, pattern) {
-				return type_
+// matchAudioType matches audio model types and returns the type with its specific capability.
+// Realtime is checked first since realtime models may also mention transcription or speech.
+func (pm *PatternMatcher) matchAudioType(modelName string) (string, string) {
+	modelLower := strings.ToLower(modelName)
+
+	audioTypes := []struct {
+		modelType  string
+		capability string
+	}{
+		{TypeRealtime, CapRealtime},
+		{TypeTTS, CapTextToSpeech},
+		{TypeSpeech, CapSpeechToText},
+	}
+
+	for _, audioType := range audioTypes {
+		for _, pattern := range pm.typePatterns[audioType.modelType] {
+			if strings.Contains(modelLower, pattern) {
+				return audioType.modelType, audioType.capability
 			}
 		}
 	}
 
-	return ""
+	return "", ""
+}
+
+// matchTypeByPattern matches model type by generic patterns
+func (pm *PatternMatcher) matchTypeByPattern(modelName string) string {
+	type_, _ := pm.matchTypePattern(modelName)
+	return type_
+}
+
+// matchTypePattern matches model type by generic patterns, also returning the pattern that matched
+func (pm *PatternMatcher) matchTypePattern(modelName string) (string, string) {
+	return matchOrdered(pm.typeOrder, modelName)
 }
 
+// gptOSSSizePattern captures the parameter size of a GPT-OSS model (e.g. "120b")
+var gptOSSSizePattern = regexp.MustCompile(`gpt-oss-(\d+b)\b`)
+
 // matchOpenAIVariant matches OpenAI variant names
 func (pm *PatternMatcher) matchOpenAIVariant(modelName string) string {
 	modelLower := strings.ToLower(modelName)
 
 	switch {
+	case strings.Contains(modelLower, "gpt-oss"):
+		if match := gptOSSSizePattern.FindStringSubmatch(modelLower); match != nil {
+			return "GPT-OSS " + strings.ToUpper(match[1])
+		}
+		return SeriesGPTOSS
 	case strings.Contains(modelLower, "gpt-4.5"):
 		return "GPT-" + Version45
 	case strings.Contains(modelLower, "gpt-4o-mini"):
@@ -266,9 +530,9 @@ func (pm *PatternMatcher) matchAnthropicVariant(modelName string) string {
 	modelLower := strings.ToLower(modelName)
 
 	switch {
-	case strings.Contains(modelLower, "claude-3.7"):
+	case strings.Contains(modelLower, "claude-3.7") || strings.Contains(modelLower, "claude-3-7"):
 		return "Claude " + Version37
-	case strings.Contains(modelLower, "claude-3.5"):
+	case strings.Contains(modelLower, "claude-3.5") || strings.Contains(modelLower, "claude-3-5"):
 		return "Claude " + Version35
 	case strings.Contains(modelLower, "claude-3"):
 		return "Claude " + Version30
@@ -281,9 +545,153 @@ func (pm *PatternMatcher) matchAnthropicVariant(modelName string) string {
 	}
 }
 
-// buildGeminiVariant builds Gemini variant string
-func (pm *PatternMatcher) buildGeminiVariant(modelName string) string {
+// matchStabilityVariant matches Stability AI variant names
+func (pm *PatternMatcher) matchStabilityVariant(modelName string) string {
+	modelLower := strings.ToLower(modelName)
+
+	switch {
+	case strings.Contains(modelLower, "stable-image-ultra"):
+		return "Stable Image Ultra"
+	case strings.Contains(modelLower, "stable-image-core"):
+		return "Stable Image Core"
+	case strings.Contains(modelLower, "stable-diffusion-3.5"), strings.Contains(modelLower, "sd3.5"):
+		return "Stable Diffusion 3.5"
+	case strings.Contains(modelLower, "stable-diffusion-3"), strings.Contains(modelLower, "sd3"):
+		return "Stable Diffusion 3"
+	case strings.Contains(modelLower, "sdxl"), strings.Contains(modelLower, "stable-diffusion-xl"):
+		return "SDXL"
+	default:
+		return ""
+	}
+}
+
+// familyPattern maps a model name pattern to its brand family
+type familyPattern struct {
+	pattern string
+	family  string
+}
+
+// familyPatterns lists the top-level model families. Order matters: more specific
+// patterns (e.g. "gpt-image" before "gpt") come first, and the short O-series
+// markers come last so they don't match inside other model names.
+var familyPatterns = []familyPattern{
+	{"gpt-image", "GPT Image"},
+	{"dall-e", "DALL-E"},
+	{"whisper", "Whisper"},
+	{"tts-", "TTS"},
+	{"text-embedding-3", "OpenAI Embedding"}, // Gemini's text-embedding-004 shares the prefix
+	{"text-embedding-ada", "OpenAI Embedding"},
+	{"gpt-oss", "GPT-OSS"},
+	{"gpt", "GPT"},
+	{"claude", "Claude"},
+	{"gemma", "Gemma"},
+	{"gemini", "Gemini"},
+	{"imagen", "Imagen"},
+	{"llama", "Llama"},
+	{"codestral", "Mistral"},
+	{"mixtral", "Mistral"},
+	{"mistral", "Mistral"},
+	{"stable-diffusion", SeriesStableDiffusion},
+	{"stable-image", SeriesStableDiffusion},
+	{"sdxl", SeriesStableDiffusion},
+	{"sd3", SeriesStableDiffusion},
+	{"flux", "FLUX"},
+	{"qwen", "Qwen"},
+	{"phi-", "Phi"},
+	{"deepseek", "DeepSeek"},
+	{"command", "Command"},
+	{"o1", "O Series"},
+	{"o3", "O Series"},
+	{"o4", "O Series"},
+}
+
+// distillPattern splits a distilled model name into its teacher origin and student base,
+// e.g. "deepseek-r1-distill-qwen-32b" into "deepseek-r1" and "qwen-32b"
+var distillPattern = regexp.MustCompile(`^(.*?)[-_]distill(?:ed)?[-_](.+)$`)
+
+// splitDistilled reports whether a model is distilled, returning the origin (teacher)
+// and base (student architecture) parts of its name
+func splitDistilled(modelName string) (origin, base string, ok bool) {
+	match := distillPattern.FindStringSubmatch(strings.ToLower(modelName))
+	if match == nil {
+		return "", "", false
+	}
+	origin = match[1]
+	if idx := strings.LastIndex(origin, "/"); idx >= 0 {
+		origin = origin[idx+1:]
+	}
+	return origin, match[2], true
+}
+
+// matchFamilyPattern matches the brand family of a model (e.g. "GPT" for "gpt-4o") along
+// with the pattern that matched, returning empty strings when no family is known
+func (pm *PatternMatcher) matchFamilyPattern(modelName string) (string, string) {
 	modelLower := strings.ToLower(modelName)
+	for _, fp := range familyPatterns {
+		if strings.Contains(modelLower, fp.pattern) {
+			return fp.family, fp.pattern
+		}
+	}
+	return "", ""
+}
+
+// Tuning suffix patterns; "-it" is the instruction-tuned suffix used by Gemma
+var (
+	instructTuningPattern = regexp.MustCompile(`[-_](instruct|it)([-_.:]|$)`)
+	chatTuningPattern     = regexp.MustCompile(`[-_]chat([-_.:]|$)`)
+	baseTuningPattern     = regexp.MustCompile(`[-_]base([-_.:]|$)`)
+	parameterSizePattern  = regexp.MustCompile(`(^|[-_/])(\d+x)?\d+(\.\d+)?b([-_.:]|$)`)
+)
+
+// matchTuning detects whether a model is a base, instruct or chat tune from its suffix.
+// Open-weight checkpoints named only by size (e.g. "llama-3.1-70b") are base models;
+// other untagged models are hosted API models with no tuning distinction.
+func (pm *PatternMatcher) matchTuning(modelName string, isOpenWeight bool) string {
+	modelLower := strings.ToLower(modelName)
+
+	// Hosted endpoints of open models (e.g. "open-mistral-7b", "ministral-3b-latest") serve chat tunes
+	hosted := strings.HasPrefix(modelLower, "open-") || strings.HasSuffix(modelLower, "-latest")
+
+	switch {
+	case strings.Contains(modelLower, "gpt-oss"):
+		// GPT-OSS is only released as a chat-tuned checkpoint, despite its size-only name
+		return TuningChat
+	case instructTuningPattern.MatchString(modelLower):
+		return TuningInstruct
+	case chatTuningPattern.MatchString(modelLower):
+		return TuningChat
+	case baseTuningPattern.MatchString(modelLower):
+		return TuningBase
+	case isOpenWeight && !hosted && parameterSizePattern.MatchString(modelLower):
+		return TuningBase
+	default:
+		return ""
+	}
+}
+
+// geminiPreviewPattern matches Gemini "-exp"/"-preview" markers with an optional date suffix
+var geminiPreviewPattern = regexp.MustCompile(`-(?:exp|preview)(?:-(\d{2}-\d{2}|\d{4}))?`)
+
+// splitGeminiPreview strips the experimental/preview marker and date from a Gemini model name,
+// returning the base name and the raw preview date (if any)
+func splitGeminiPreview(modelName string) (string, string) {
+	match := geminiPreviewPattern.FindStringSubmatchIndex(modelName)
+	if match == nil {
+		return modelName, ""
+	}
+
+	date := ""
+	if match[2] >= 0 {
+		date = modelName[match[2]:match[3]]
+	}
+	return modelName[:match[0]] + modelName[match[1]:], date
+}
+
+// buildGeminiVariant builds a clean Gemini variant string (e.g. "Gemini 2.5 Pro"),
+// ignoring experimental/preview markers and their dates
+func (pm *PatternMatcher) buildGeminiVariant(modelName string) string {
+	modelLower, _ := splitGeminiPreview(strings.ToLower(modelName))
+	isPreview := modelLower != strings.ToLower(modelName)
 
 	// Combine version with type
 	version := ""
@@ -314,28 +722,126 @@ func (pm *PatternMatcher) buildGeminiVariant(modelName string) string {
 		return "Gemini " + version
 	} else if type_ != "" {
 		return "Gemini " + type_
+	} else if isPreview {
+		return "Gemini Experimental"
 	}
 
 	return ""
 }
 
-// addCapabilities adds capabilities to the capabilities map based on model traits
-func (pm *PatternMatcher) addCapabilities(capabilities map[string]bool, modelType, modelName, provider, series string) {
+// addCapabilities adds capabilities to the capabilities map based on model traits,
+// recording for each capability the rule that assigned it
+func (pm *PatternMatcher) addCapabilities(capabilities map[string]string, modelType, modelName, provider, series string) {
 	// Vision capability
-	if strings.Contains(modelName, "vision") ||
-		strings.Contains(modelName, "multimodal") ||
-		modelType == Type4 || modelType == Type45 || modelType == TypeO ||
-		series == SeriesClaude3 ||
-		strings.Contains(modelName, "4o") ||
-		strings.Contains(series, "Gemini") {
-		capabilities[CapVision] = true
+	switch {
+	case strings.Contains(modelName, "vision"):
+		capabilities[CapVision] = `name contains "vision"`
+	case strings.Contains(modelName, "multimodal"):
+		capabilities[CapVision] = `name contains "multimodal"`
+	case modelType == Type4 || modelType == Type45 || modelType == TypeO:
+		capabilities[CapVision] = "type " + modelType
+	case series == SeriesClaude3 || strings.Contains(series, "Gemini"):
+		capabilities[CapVision] = "series " + series
+	case strings.Contains(modelName, "4o"):
+		capabilities[CapVision] = `name contains "4o"`
 	}
 
 	// Function calling capability
 	// Most modern LLMs support function calling
-	if modelType == Type4 || modelType == Type45 || modelType == Type35 || modelType == TypeO ||
-		series == SeriesClaude3 ||
-		strings.Contains(series, "Gemini") {
-		capabilities[CapFunctionCalling] = true
+	switch {
+	case series == SeriesClaude3 || strings.Contains(series, "Gemini"):
+		capabilities[CapFunctionCalling] = "series " + series
+	case modelType == Type4 || modelType == Type45 || modelType == Type35 || modelType == TypeO ||
+		modelType == TypeOSS ||
+		(series == SeriesMistral && (modelType == TypeLarge || modelType == TypeSmall || modelType == TypeMinistral)):
+		capabilities[CapFunctionCalling] = "type " + modelType
+	}
+
+	// Gemma 3 accepts images at every size except the text-only 1B
+	if modelType == TypeGemma && series == "Gemma 3" {
+		if _, size, _ := findGemmaVersion(modelName); size != "1B" {
+			capabilities[CapVision] = "series " + series + " (not 1B)"
+		}
+	}
+
+	// GPT-OSS models are reasoning models with adjustable reasoning effort
+	if modelType == TypeOSS {
+		capabilities[CapReasoning] = "type " + modelType
+	}
+
+	// Code capability for code-specialized models
+	if modelType == TypeCodestral {
+		capabilities[CapCode] = "type " + modelType
+	}
+
+	// Known variant exceptions take precedence over the heuristics above
+	for _, capability := range unsupportedCapabilities(modelName, provider) {
+		delete(capabilities, capability)
 	}
 }
+
+// Conflict describes two patterns under different keys of the same pattern table
+// where one pattern is a substring of the other, so matching order decides the result
+type Conflict struct {
+	Table        string
+	Key          string
+	Pattern      string
+	OtherKey     string
+	OtherPattern string
+}
+
+// String formats the conflict for logs and test output
+func (c Conflict) String() string {
+	return c.Table + ": " + c.Key + " pattern \"" + c.Pattern + "\" overlaps " +
+		c.OtherKey + " pattern \"" + c.OtherPattern + "\""
+}
+
+// Validate reports overlapping patterns in the pattern tables where one pattern
+// is contained in a pattern registered under a different key
+func (pm *PatternMatcher) Validate() []Conflict {
+	tables := []struct {
+		name     string
+		patterns map[string][]string
+	}{
+		{"provider", pm.providerPatterns},
+		{"series", pm.seriesPatterns},
+		{"type", pm.typePatterns},
+		{"capability", pm.capabilityPatterns},
+	}
+
+	var conflicts []Conflict
+	for _, table := range tables {
+		keys := make([]string, 0, len(table.patterns))
+		for key := range table.patterns {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			for _, otherKey := range keys {
+				if key == otherKey {
+					continue
+				}
+				for _, pattern := range table.patterns[key] {
+					for _, otherPattern := range table.patterns[otherKey] {
+						// Report each pair once: identical patterns only from the lower key
+						if pattern == otherPattern && key > otherKey {
+							continue
+						}
+						if strings.Contains(otherPattern, pattern) {
+							conflicts = append(conflicts, Conflict{
+								Table:        table.name,
+								Key:          key,
+								Pattern:      pattern,
+								OtherKey:     otherKey,
+								OtherPattern: otherPattern,
+							})
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return conflicts
+}
@@ -0,0 +1,117 @@
+package classifiers
+
+import (
+	"reflect"
+	"testing"
+)
+
+// knownPatternOverlaps are overlaps that matching order resolves on purpose: the longer
+// pattern is checked first, as TestKnownPatternOverlapsResolve confirms
+var knownPatternOverlaps = map[Conflict]bool{
+	{Table: "type", Key: TypeFlash, Pattern: "flash", OtherKey: TypeFlashLite, OtherPattern: "flash-lite"}: true,
+	{Table: "type", Key: Type4, Pattern: "gpt-4", OtherKey: Type45, OtherPattern: "gpt-4.5"}:               true,
+	{Table: "type", Key: Type4, Pattern: "gpt4", OtherKey: Type45, OtherPattern: "gpt4.5"}:                 true,
+}
+
+func TestPatternMatcherValidate(t *testing.T) {
+	seen := make(map[Conflict]bool)
+	for _, conflict := range NewPatternMatcher().Validate() {
+		seen[conflict] = true
+		if !knownPatternOverlaps[conflict] {
+			t.Errorf("unexpected pattern overlap: %s", conflict)
+		}
+	}
+	for conflict := range knownPatternOverlaps {
+		if !seen[conflict] {
+			t.Errorf("allowlisted overlap no longer reported, remove it: %s", conflict)
+		}
+	}
+}
+
+func TestKnownPatternOverlapsResolve(t *testing.T) {
+	mc := NewModelClassifier()
+
+	tests := []struct {
+		modelID  string
+		wantType string
+	}{
+		{"gemini-2.0-flash-lite", TypeFlashLite},
+		{"gemini-2.0-flash", TypeFlash},
+		{"gpt-4.5-preview", Type45},
+		{"gpt-4-turbo", Type4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.modelID, func(t *testing.T) {
+			if got := mc.ClassifyModel(tt.modelID, "").Type; got != tt.wantType {
+				t.Errorf("ClassifyModel(%q).Type = %q, want %q", tt.modelID, got, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestPatternMatchingIsDeterministic(t *testing.T) {
+	// Each name matches patterns of several providers, series or types, so an
+	// order taken from map iteration would flip between matcher builds
+	ambiguous := []string{
+		"claude-llama-mix",
+		"mixtral-gpt-4-merge",
+		"gemma-llama-distill",
+		"stable-diffusion-gemini",
+		"openai-mistral-7b",
+		"gpt-4.5-flash-lite",
+	}
+
+	first := make(map[string]ModelMetadata, len(ambiguous))
+	firstProvider := make(map[string]string, len(ambiguous))
+	for run := 0; run < 20; run++ {
+		// Rebuild the matcher every run so each build gets fresh map iteration order
+		mc := NewModelClassifier()
+		pm := NewPatternMatcher()
+		for _, modelID := range ambiguous {
+			metadata := mc.ClassifyModel(modelID, "")
+			provider := pm.matchProviderByPattern(modelID)
+			if run == 0 {
+				first[modelID], firstProvider[modelID] = metadata, provider
+				continue
+			}
+			if provider != firstProvider[modelID] {
+				t.Errorf("run %d: matchProviderByPattern(%q) = %q, first run gave %q", run, modelID, provider, firstProvider[modelID])
+			}
+			if !reflect.DeepEqual(metadata, first[modelID]) {
+				t.Errorf("run %d: ClassifyModel(%q) = %+v, first run gave %+v", run, modelID, metadata, first[modelID])
+			}
+		}
+	}
+}
+
+func TestMatchAudioType(t *testing.T) {
+	pm := NewPatternMatcher()
+
+	tests := []struct {
+		name           string
+		modelName      string
+		wantType       string
+		wantCapability string
+	}{
+		{"speech to text", "whisper-1", TypeSpeech, CapSpeechToText},
+		{"text to speech", "tts-1-hd", TypeTTS, CapTextToSpeech},
+		{"realtime wins over transcription", "gpt-4o-realtime-transcribe", TypeRealtime, CapRealtime},
+		{"realtime wins over tts", "gpt-4o-realtime-tts", TypeRealtime, CapRealtime},
+		{"case insensitive", "Whisper-Large-V3", TypeSpeech, CapSpeechToText},
+		{"chat model", "gpt-4o", "", ""},
+		{"audio chat model", "gpt-4o-audio-preview", "", ""},
+		{"empty name", "", "", ""},
+		{"routing suffix only", ":free", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotType, gotCapability := pm.matchAudioType(tt.modelName)
+			if gotType != tt.wantType || gotCapability != tt.wantCapability {
+				t.Errorf("matchAudioType(%q) = (%q, %q), want (%q, %q)",
+					tt.modelName, gotType, gotCapability, tt.wantType, tt.wantCapability)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package classifiers
+
+import "strings"
+
+// ProviderAliases maps lowercase provider names and organization prefixes that clients
+// and aggregators use to the canonical provider constants. Google is a single provider:
+// every Google name, including the legacy "gemini" id, resolves to ProviderGoogle,
+// whichever model family it serves.
+var ProviderAliases = map[string]string{
+	"openai":        ProviderOpenAI,
+	"anthropic":     ProviderAnthropicA,
+	"claude":        ProviderAnthropicA,
+	"gemini":        ProviderGoogle,
+	"google":        ProviderGoogle,
+	"google-ai":     ProviderGoogle,
+	"googleai":      ProviderGoogle,
+	"vertex":        ProviderGoogle,
+	"vertexai":      ProviderGoogle,
+	"google-vertex": ProviderGoogle,
+	"meta":          ProviderMeta,
+	"meta-llama":    ProviderMeta,
+	"facebook":      ProviderMeta,
+	"mistral":       ProviderMistral,
+	"mistralai":     ProviderMistral,
+	"mistral-ai":    ProviderMistral,
+	"stability":     ProviderStability,
+	"stabilityai":   ProviderStability,
+	"stability-ai":  ProviderStability,
+	"openrouter":    ProviderOpenrouter,
+}
+
+// NormalizeProvider lowercases a provider name and resolves known aliases
+// (e.g. "Gemini" to "google"); unknown providers are returned lowercased
+func NormalizeProvider(provider string) string {
+	providerLower := strings.ToLower(strings.TrimSpace(provider))
+	if canonical, ok := ProviderAliases[providerLower]; ok {
+		return canonical
+	}
+	return providerLower
+}
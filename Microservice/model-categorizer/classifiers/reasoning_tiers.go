@@ -0,0 +1,64 @@
+package classifiers
+
+import (
+	"sort"
+	"strings"
+)
+
+// Reasoning effort levels a client can select for a reasoning model
+const (
+	EffortNone   = "none" // Thinking disabled
+	EffortLow    = "low"
+	EffortMedium = "medium"
+	EffortHigh   = "high"
+)
+
+// reasoningTierEntry lists the effort levels for models whose ID starts with prefix.
+// A nil tier list marks reasoning models that don't accept an effort setting.
+type reasoningTierEntry struct {
+	prefix string
+	tiers  []string
+}
+
+// reasoningTierRegistry lists the reasoning models with configurable effort.
+// Entries are matched longest prefix first, so "o1-mini" wins over "o1".
+var reasoningTierRegistry = sortedReasoningTiers([]reasoningTierEntry{
+	{"o1", []string{EffortLow, EffortMedium, EffortHigh}},
+	{"o1-mini", nil},
+	{"o1-preview", nil},
+	{"o3", []string{EffortLow, EffortMedium, EffortHigh}},
+	{"o3-mini", []string{EffortLow, EffortMedium, EffortHigh}},
+	{"o4-mini", []string{EffortLow, EffortMedium, EffortHigh}},
+	{"gpt-oss", []string{EffortLow, EffortMedium, EffortHigh}},
+	// Gemini thinking budgets; Flash can turn thinking off, Pro cannot
+	{"gemini-2.5-flash", []string{EffortNone, EffortLow, EffortMedium, EffortHigh}},
+	{"gemini-2.5-pro", []string{EffortLow, EffortMedium, EffortHigh}},
+})
+
+// sortedReasoningTiers orders entries longest prefix first
+func sortedReasoningTiers(entries []reasoningTierEntry) []reasoningTierEntry {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return len(entries[i].prefix) > len(entries[j].prefix)
+	})
+	return entries
+}
+
+// ReasoningTiers returns the reasoning effort levels a model accepts, or nil when it
+// has no effort selector. Any "provider/" prefix is ignored.
+func ReasoningTiers(modelID string) []string {
+	modelLower := strings.ToLower(modelID)
+	if idx := strings.LastIndex(modelLower, "/"); idx >= 0 {
+		modelLower = modelLower[idx+1:]
+	}
+
+	for _, entry := range reasoningTierRegistry {
+		if strings.HasPrefix(modelLower, entry.prefix) {
+			if entry.tiers == nil {
+				return nil
+			}
+			// Copy so callers can't modify the registry
+			return append([]string(nil), entry.tiers...)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package classifiers
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestReasoningTiers(t *testing.T) {
+	lowMediumHigh := []string{EffortLow, EffortMedium, EffortHigh}
+
+	tests := []struct {
+		modelID string
+		want    []string
+	}{
+		{"o3-mini", lowMediumHigh},
+		{"o3-mini-2025-01-31", lowMediumHigh},
+		{"openai/O3-Mini", lowMediumHigh},
+		{"o1", lowMediumHigh},
+		{"gemini-2.5-flash", []string{EffortNone, EffortLow, EffortMedium, EffortHigh}},
+		// Reasoning models without an effort setting
+		{"o1-mini", nil},
+		{"o1-preview", nil},
+		// Non-reasoning models
+		{"gpt-4o", nil},
+		{"claude-3-5-sonnet-20241022", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.modelID, func(t *testing.T) {
+			if got := ReasoningTiers(tt.modelID); !slices.Equal(got, tt.want) {
+				t.Errorf("ReasoningTiers(%q) = %v, want %v", tt.modelID, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReasoningTiersReturnsCopy(t *testing.T) {
+	tiers := ReasoningTiers("o3-mini")
+	tiers[0] = "mutated"
+
+	if again := ReasoningTiers("o3-mini"); again[0] != EffortLow {
+		t.Errorf("ReasoningTiers shared the registry slice; got %v", again)
+	}
+}
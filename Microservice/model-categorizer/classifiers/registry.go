@@ -0,0 +1,95 @@
+package classifiers
+
+import (
+	_ "embed"
+	"encoding/json"
+	"strings"
+)
+
+//go:embed known_models.json
+var knownModelsJSON []byte
+
+// RegistryEntry holds authoritative metadata for a well-known model.
+// Empty fields are left to the heuristic classifiers.
+type RegistryEntry struct {
+	Provider             string   `json:"provider,omitempty"`
+	Series               string   `json:"series,omitempty"`
+	Type                 string   `json:"type,omitempty"`
+	Variant              string   `json:"variant,omitempty"`
+	Context              int      `json:"context,omitempty"`
+	Capabilities         []string `json:"capabilities,omitempty"`
+	IsMultimodal         bool     `json:"is_multimodal,omitempty"`
+	DisplayName          string   `json:"display_name,omitempty"`
+	InputPricePerMillion float64  `json:"input_price_per_million,omitempty"`
+}
+
+// ModelRegistry is a curated catalog of well-known models keyed by canonical ID.
+// Entries are loaded once and never modified, so it is safe for concurrent use;
+// apply copies slices so callers cannot mutate shared entries.
+type ModelRegistry struct {
+	entries map[string]RegistryEntry
+}
+
+// NewModelRegistry loads the registry from the embedded known models file
+func NewModelRegistry() *ModelRegistry {
+	entries := make(map[string]RegistryEntry)
+	if err := json.Unmarshal(knownModelsJSON, &entries); err != nil {
+		// The file is embedded at build time, so a parse failure is a programming error
+		panic("classifiers: invalid known_models.json: " + err.Error())
+	}
+
+	return &ModelRegistry{
+		entries: entries,
+	}
+}
+
+// Len returns the number of models in the registry
+func (r *ModelRegistry) Len() int {
+	return len(r.entries)
+}
+
+// Lookup finds a registry entry by exact ID, ignoring case and any "provider/" prefix
+func (r *ModelRegistry) Lookup(modelID string) (RegistryEntry, bool) {
+	modelLower := strings.ToLower(modelID)
+	if entry, ok := r.entries[modelLower]; ok {
+		return entry, true
+	}
+
+	if idx := strings.LastIndex(modelLower, "/"); idx >= 0 {
+		entry, ok := r.entries[modelLower[idx+1:]]
+		return entry, ok
+	}
+
+	return RegistryEntry{}, false
+}
+
+// apply overrides heuristic metadata with the entry's non-empty fields
+func (e RegistryEntry) apply(metadata *ModelMetadata) {
+	if e.Provider != "" {
+		metadata.Provider = NormalizeProvider(e.Provider)
+	}
+	if e.Series != "" {
+		metadata.Series = e.Series
+	}
+	if e.Type != "" {
+		metadata.Type = e.Type
+	}
+	if e.Variant != "" {
+		metadata.Variant = e.Variant
+	}
+	if e.Context > 0 {
+		metadata.Context = e.Context
+	}
+	if len(e.Capabilities) > 0 {
+		// NormalizeCapabilities returns a fresh slice, keeping the shared entry untouched
+		metadata.Capabilities = NormalizeCapabilities(e.Capabilities)
+		metadata.IsMultimodal = e.IsMultimodal
+	}
+	if e.DisplayName != "" {
+		metadata.DisplayName = e.DisplayName
+	}
+	if e.InputPricePerMillion > 0 {
+		metadata.CostPerToken = e.InputPricePerMillion / 1000000
+	}
+	metadata.RegistryMatch = true
+}
@@ -0,0 +1,93 @@
+package classifiers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRegistryOverridesHeuristics(t *testing.T) {
+	mc := NewModelClassifier()
+	// The same classifier without registry entries shows what the heuristics guess
+	heuristic := NewModelClassifier()
+	heuristic.registry = &ModelRegistry{entries: map[string]RegistryEntry{}}
+
+	tests := []struct {
+		modelID          string
+		wantProvider     string
+		wantContext      int
+		wantCapabilities []string
+	}{
+		{"o1", ProviderOpenAI, 200000, []string{"chat", "function-calling", "vision"}},
+		{"gpt-3.5-turbo", ProviderOpenAI, 16385, []string{"chat", "function-calling"}},
+		{"gemini-1.5-pro", ProviderGoogle, 2097152, []string{"chat", "function-calling", "vision"}},
+		{"o3-mini", ProviderOpenAI, 200000, []string{"chat", "function-calling"}},
+		{"text-embedding-3-small", ProviderOpenAI, 8191, []string{"embedding"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.modelID, func(t *testing.T) {
+			entry, ok := mc.registry.Lookup(tt.modelID)
+			if !ok {
+				t.Fatalf("%q is not in the registry", tt.modelID)
+			}
+
+			got := mc.ClassifyModel(tt.modelID, "")
+			guess := heuristic.ClassifyModel(tt.modelID, "")
+			if guess.Provider == tt.wantProvider && guess.Context == tt.wantContext &&
+				reflect.DeepEqual(guess.Capabilities, tt.wantCapabilities) {
+				t.Fatalf("heuristics already agree with the registry for %q; pick a model they get wrong", tt.modelID)
+			}
+
+			if got.Provider != tt.wantProvider {
+				t.Errorf("Provider = %q, want %q (heuristic %q)", got.Provider, tt.wantProvider, guess.Provider)
+			}
+			if got.Context != tt.wantContext {
+				t.Errorf("Context = %d, want %d (heuristic %d)", got.Context, tt.wantContext, guess.Context)
+			}
+			if !reflect.DeepEqual(got.Capabilities, tt.wantCapabilities) {
+				t.Errorf("Capabilities = %v, want %v (heuristic %v)", got.Capabilities, tt.wantCapabilities, guess.Capabilities)
+			}
+			if want := entry.InputPricePerMillion / 1000000; got.CostPerToken != want {
+				t.Errorf("CostPerToken = %g, want %g", got.CostPerToken, want)
+			}
+			if !got.RegistryMatch || guess.RegistryMatch {
+				t.Errorf("RegistryMatch = %v (heuristic %v), want true (false)", got.RegistryMatch, guess.RegistryMatch)
+			}
+		})
+	}
+}
+
+func TestRegistryLookup(t *testing.T) {
+	registry := NewModelRegistry()
+
+	tests := []struct {
+		modelID string
+		wantOK  bool
+	}{
+		{"gpt-4o", true},
+		{"GPT-4o", true},
+		{"openai/gpt-4o", true},
+		{"openrouter/openai/gpt-4o", true},
+		{"gpt-4o-2024-05-13", false},
+		{"unknown-model", false},
+	}
+
+	for _, tt := range tests {
+		if _, ok := registry.Lookup(tt.modelID); ok != tt.wantOK {
+			t.Errorf("Lookup(%q) ok = %v, want %v", tt.modelID, ok, tt.wantOK)
+		}
+	}
+}
+
+func TestRegistryEntryApplyCopiesCapabilities(t *testing.T) {
+	registry := NewModelRegistry()
+	entry, _ := registry.Lookup("gpt-4o")
+
+	var metadata ModelMetadata
+	entry.apply(&metadata)
+	metadata.Capabilities[0] = "mutated"
+
+	if again, _ := registry.Lookup("gpt-4o"); again.Capabilities[0] == "mutated" {
+		t.Error("apply shared the registry's capability slice with the caller")
+	}
+}
@@ -0,0 +1,151 @@
+package classifiers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TraceStep records the rule that decided one classification field. Capabilities get
+// one step each, with the capability as the value.
+type TraceStep struct {
+	Field string `json:"field"`
+	Value string `json:"value"`
+	Rule  string `json:"rule"`
+}
+
+// ClassifyWithTrace classifies a model like ClassifyModel and also reports which
+// pattern, heuristic or registry entry decided each field, for debugging misclassifications
+func (mc *ModelClassifier) ClassifyWithTrace(modelID, providerHint string) (ModelMetadata, []TraceStep) {
+	metadata := mc.ClassifyModel(modelID, providerHint)
+
+	// Follow the same path as ClassifyModel, collecting rules instead of values
+	baseID, routingVariant, quantization := splitSuffixes(modelID)
+	modelLower := strings.ToLower(baseID)
+	distillOrigin, distillBase, isDistilled := splitDistilled(modelLower)
+
+	rules := make(map[string]string)
+	var capabilityRules map[string]string
+	switch {
+	case isDistilled:
+		capabilityRules = mc.traceStandard(rules, distillBase, providerHint)
+		capabilityRules[CapChat] = "distilled"
+		if strings.Contains(distillOrigin, "r1") {
+			capabilityRules[CapReasoning] = "distilled from R1"
+		}
+		rules["distilled_from"] = "distill pattern"
+	case mc.isImageGenerationModel(modelLower):
+		capabilityRules = traceModelKind(rules, "image generation model")
+		_, rules["provider"] = mc.providerRule(modelLower, providerHint)
+	case mc.isRerankerModel(modelLower):
+		capabilityRules = traceModelKind(rules, "reranker model")
+		_, rules["provider"] = mc.providerRule(modelLower, providerHint)
+	case mc.isEmbeddingModel(modelLower):
+		capabilityRules = traceModelKind(rules, "embedding model")
+		_, rules["provider"] = mc.providerRule(modelLower, providerHint)
+	case mc.isAudioModel(modelLower):
+		capabilityRules = traceModelKind(rules, "audio model")
+		_, rules["provider"] = mc.providerRule(modelLower, providerHint)
+	default:
+		baseName, reasoning := stripReasoningSuffix(modelLower)
+		capabilityRules = mc.traceStandard(rules, baseName, providerHint)
+		if reasoning {
+			capabilityRules[CapReasoning] = "reasoning suffix"
+		} else if routingVariant == "thinking" {
+			capabilityRules[CapReasoning] = `routing variant ":thinking"`
+		}
+	}
+
+	if metadata.RawFamily != "" {
+		rules["family"] = fmt.Sprintf("family pattern %q", metadata.RawFamily)
+	} else {
+		rules["family"] = "series fallback"
+	}
+
+	// Registry fields override whatever the heuristics decided
+	if entry, ok := mc.registry.Lookup(baseID); ok {
+		registryRule := fmt.Sprintf("registry entry %q", baseID)
+		for field, value := range map[string]string{
+			"provider": entry.Provider,
+			"series":   entry.Series,
+			"type":     entry.Type,
+			"variant":  entry.Variant,
+		} {
+			if value != "" {
+				rules[field] = registryRule
+			}
+		}
+		if len(entry.Capabilities) > 0 {
+			capabilityRules = make(map[string]string)
+			for _, capability := range metadata.Capabilities {
+				capabilityRules[capability] = registryRule
+			}
+		}
+	}
+
+	steps := []TraceStep{
+		{Field: "provider", Value: metadata.Provider, Rule: rules["provider"]},
+		{Field: "series", Value: metadata.Series, Rule: rules["series"]},
+		{Field: "type", Value: metadata.Type, Rule: rules["type"]},
+		{Field: "variant", Value: metadata.Variant, Rule: rules["variant"]},
+		{Field: "family", Value: metadata.Family, Rule: rules["family"]},
+	}
+	for _, capability := range metadata.Capabilities {
+		rule := capabilityRules[capability]
+		if rule == "" {
+			rule = "derived"
+		}
+		steps = append(steps, TraceStep{Field: "capability", Value: capability, Rule: rule})
+	}
+	if quantization != "" {
+		steps = append(steps, TraceStep{Field: "quantization", Value: quantization, Rule: "quantization suffix"})
+	}
+	if routingVariant != "" {
+		steps = append(steps, TraceStep{Field: "routing_variant", Value: routingVariant, Rule: "routing suffix"})
+	}
+	if isDistilled {
+		steps = append(steps, TraceStep{Field: "distilled_from", Value: metadata.DistilledFrom, Rule: rules["distilled_from"]})
+	}
+
+	return metadata, steps
+}
+
+// ClassifyWithNameTrace is ClassifyWithTrace with the display-name fallback of
+// ClassifyModelWithName, so the trace matches what ClassifyModelWithName returns
+func (mc *ModelClassifier) ClassifyWithNameTrace(modelID, name, providerHint string) (ModelMetadata, []TraceStep) {
+	return mc.classifyWithName(modelID, name, providerHint, mc.ClassifyWithTrace)
+}
+
+// traceStandard records the rules buildStandardModelMetadata applies to a model name
+// and returns the rule behind each heuristic capability
+func (mc *ModelClassifier) traceStandard(rules map[string]string, modelName, providerHint string) map[string]string {
+	var provider, series string
+	provider, rules["provider"] = mc.providerRule(modelName, providerHint)
+	series, rules["series"] = mc.seriesRule(modelName, provider)
+	_, rules["type"] = mc.typeRule(modelName, provider, series)
+
+	alias, _ := mc.ResolveSnapshot(modelName)
+	if provider == ProviderMistral {
+		alias = modelName
+	}
+	_, rules["variant"] = mc.variantRule(alias, provider, series)
+
+	return mc.capabilityRules(modelName, provider, series)
+}
+
+// traceModelKind records a special model kind (image, embedding, audio) as the rule
+// for every field it fixes
+func traceModelKind(rules map[string]string, kind string) map[string]string {
+	rules["series"] = kind
+	rules["type"] = kind
+	rules["variant"] = kind
+	return map[string]string{
+		CapImageGeneration: kind,
+		CapEmbedding:       kind,
+		CapRerank:          kind,
+		CapAudio:           kind,
+		CapSpeechToText:    kind,
+		CapTextToSpeech:    kind,
+		CapRealtime:        kind,
+		CapChat:            kind,
+	}
+}
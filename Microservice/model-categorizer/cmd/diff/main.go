@@ -0,0 +1,72 @@
+// Command diff compares two JSON model catalog dumps and prints the added,
+// removed and changed models.
+//
+// Usage:
+//
+//	diff old.json new.json
+//
+// Each dump may be either a JSON array of models or an object with a "models" array.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/chat-api/model-categorizer/models"
+)
+
+func main() {
+	if len(os.Args) != 3 {
+		fmt.Fprintln(os.Stderr, "usage: diff <old.json> <new.json>")
+		os.Exit(2)
+	}
+
+	oldModels, err := loadCatalog(os.Args[1])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load %s: %v\n", os.Args[1], err)
+		os.Exit(1)
+	}
+	newModels, err := loadCatalog(os.Args[2])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load %s: %v\n", os.Args[2], err)
+		os.Exit(1)
+	}
+
+	diff := models.DiffCatalogs(oldModels, newModels)
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(diff); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to encode diff: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Non-zero exit lets scripts detect catalog changes
+	if !diff.IsEmpty() {
+		os.Exit(3)
+	}
+}
+
+// loadCatalog reads a model dump, accepting either a bare array or a LoadedModelList
+func loadCatalog(path string) ([]*models.Model, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		var list []*models.Model
+		if err := json.Unmarshal(trimmed, &list); err != nil {
+			return nil, err
+		}
+		return list, nil
+	}
+
+	var loaded models.LoadedModelList
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		return nil, err
+	}
+	return loaded.Models, nil
+}
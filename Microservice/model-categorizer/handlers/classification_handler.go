@@ -3,60 +3,163 @@ package handlers
 import (
 	"context"
 	"encoding/json"
-	"log"
+	"fmt"
+	"log/slog"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/chat-api/model-categorizer/classifiers"
 	"github.com/chat-api/model-categorizer/models"
 	"github.com/chat-api/model-categorizer/models/proto"
+	"github.com/chat-api/model-categorizer/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Constants for property names
 const (
-	PropertyProvider      = "provider"
-	PropertyFamily        = "family"
-	PropertyType          = "type"
-	PropertySeries        = "series"
-	PropertyVariant       = "variant"
-	PropertyCapability    = "capability"
-	PropertyContextWindow = "context_window"
-	PropertyMultimodal    = "multimodal"
+	PropertyProvider       = "provider"
+	PropertyFamily         = "family"
+	PropertyType           = "type"
+	PropertySeries         = "series"
+	PropertyVariant        = "variant"
+	PropertyCapability     = "capability"
+	PropertyContextWindow  = "context_window"
+	PropertyMultimodal     = "multimodal"
+	PropertyQuantization   = "quantization"
+	PropertyLicense        = "license"
+	PropertyTuning         = "tuning"
+	PropertyInputModality  = "input_modality"
+	PropertyOutputModality = "output_modality"
+	PropertyTag            = "tag"
+	PropertyDistilled      = "distilled"
+
+	// PropertyMetadataPrefix marks a dynamic property that groups by a client metadata
+	// key, e.g. "metadata:tier" groups by model.Metadata["tier"]
+	PropertyMetadataPrefix = "metadata:"
+)
+
+// tracer creates spans around the expensive classification stages
+var tracer = otel.Tracer("github.com/chat-api/model-categorizer/handlers")
+
+// Provider grouping modes for ClassificationCriteria.ProviderGrouping
+const (
+	ProviderGroupingOriginal = "original"
+	ProviderGroupingResolved = "resolved"
 )
 
 // DefaultClassificationProperties returns the default properties for classification
 var DefaultClassificationProperties = []string{PropertyProvider, PropertyFamily, PropertyType, PropertyCapability}
 
+// DefaultHierarchyDimensions are the levels of the hierarchy when none are requested
+var DefaultHierarchyDimensions = []string{PropertyProvider, PropertyType, PropertyVariant}
+
+// DefaultMaxHierarchyDepth is the default cap on the number of hierarchy levels
+const DefaultMaxHierarchyDepth = 4
+
 // StandardContextSizes maps model IDs to their standard context sizes
 // Currently only used for Gemini models
 var StandardContextSizes = map[string]int32{
 	// Gemini models
-	"gemini-1.5-pro":                       1000000,
-	"gemini-1.5-pro-latest":                1000000,
-	"gemini-1.5-flash":                     1000000,
-	"gemini-1.5-flash-latest":              1000000,
-	"gemini-1.0-pro":                       32768,
-	"gemini-1.0-pro-vision":                32768,
-	"gemini-1.0-pro-vision-latest":         32768,
-	"gemini-2.0-pro":                       1000000,
-	"gemini-2.0-flash":                     1000000,
-	"gemini-2.5-pro":                       1000000,
-}
-
-// ModelClassificationHandler handles gRPC requests for model classification
+	"gemini-1.5-pro":               1000000,
+	"gemini-1.5-pro-latest":        1000000,
+	"gemini-1.5-flash":             1000000,
+	"gemini-1.5-flash-latest":      1000000,
+	"gemini-1.0-pro":               32768,
+	"gemini-1.0-pro-vision":        32768,
+	"gemini-1.0-pro-vision-latest": 32768,
+	"gemini-2.0-pro":               1000000,
+	"gemini-2.0-flash":             1000000,
+	"gemini-2.5-pro":               1000000,
+}
+
+// ModelClassificationHandler handles gRPC requests for model classification.
+// RPCs run concurrently; the classifier is read-only and swapped whole on reload, and the
+// response cache is locked.
 type ModelClassificationHandler struct {
 	proto.UnimplementedModelClassificationServiceServer
-	classifier    *classifiers.ModelClassifier
+	live          atomic.Pointer[classifierState]
+	generations   atomic.Uint64 // Source of classifierState generations
 	enableLogging bool
+	responses     *responseCache
+	completed     *responseCache // Completed responses keyed by client request ID
+
+	maxModelsPerRequest int
+	maxHierarchyDepth   int
+	classifierOpts      []classifiers.Option
+	familyDisplayNames  map[string]string
+	startTime           time.Time
+
+	adminToken string                   // Enables ReloadConfiguration when set
+	loadConfig func() ([]Option, error) // Reads fresh classifier options for ReloadConfig
+	reloadMu   sync.Mutex               // Serializes ReloadConfig so reloads apply in order
 }
 
 // NewModelClassificationHandler creates a new handler for model classification
-func NewModelClassificationHandler(enableLogging bool) *ModelClassificationHandler {
-	return &ModelClassificationHandler{
-		classifier:    classifiers.NewModelClassifier(),
-		enableLogging: enableLogging,
+func NewModelClassificationHandler(enableLogging bool, opts ...Option) *ModelClassificationHandler {
+	h := &ModelClassificationHandler{
+		enableLogging:       enableLogging,
+		responses:           newResponseCache(defaultResponseCacheTTL, defaultResponseCacheSize),
+		completed:           newResponseCache(defaultRequestIDTTL, defaultResponseCacheSize),
+		maxModelsPerRequest: DefaultMaxModelsPerRequest,
+		maxHierarchyDepth:   DefaultMaxHierarchyDepth,
+		startTime:           time.Now(),
+	}
+	for _, opt := range opts {
+		opt(h)
 	}
+	h.live.Store(newClassifierState(h.classifierOpts, h.familyDisplayNames, h.generations.Add(1)))
+	return h
+}
+
+// checkModelLimit rejects requests carrying more models than the configured limit
+func (h *ModelClassificationHandler) checkModelLimit(method string, count int) error {
+	if h.maxModelsPerRequest <= 0 || count <= h.maxModelsPerRequest {
+		return nil
+	}
+	slog.Warn("Rejected request exceeding model limit",
+		"method", method,
+		"models", count,
+		"limit", h.maxModelsPerRequest)
+	return status.Errorf(codes.ResourceExhausted,
+		"request contains %d models, exceeding the limit of %d", count, h.maxModelsPerRequest)
+}
+
+// dedupeModels drops repeated models within one request, keeping the first occurrence.
+// Models match on models.CanonicalID, so the same ID from two providers is kept.
+// Metadata keys missing from the kept model are filled in from its duplicates.
+func dedupeModels(method string, modelsList []*models.Model) []*models.Model {
+	seen := make(map[string]*models.Model, len(modelsList))
+	result := make([]*models.Model, 0, len(modelsList))
+	for _, model := range modelsList {
+		key := models.CanonicalID(model)
+		kept, ok := seen[key]
+		if !ok {
+			seen[key] = model
+			result = append(result, model)
+			continue
+		}
+		for k, v := range model.Metadata {
+			if _, exists := kept.Metadata[k]; exists {
+				continue
+			}
+			if kept.Metadata == nil {
+				kept.Metadata = make(map[string]string)
+			}
+			kept.Metadata[k] = v
+		}
+	}
+
+	if removed := len(modelsList) - len(result); removed > 0 {
+		slog.Info("Removed duplicate models", "method", method, "duplicates", removed)
+	}
+	return result
 }
 
 // logRequest logs the request if logging is enabled
@@ -65,9 +168,9 @@ func (h *ModelClassificationHandler) logRequest(method string, req interface{})
 		return
 	}
 
-	_,err := json.MarshalIndent(req, "", "  ")
+	_, err := json.MarshalIndent(req, "", "  ")
 	if err != nil {
-		log.Printf("Error serializing request for logging: %v", err)
+		slog.Error("Error serializing request for logging", "method", method, "error", err)
 		return
 	}
 
@@ -82,30 +185,76 @@ func (h *ModelClassificationHandler) logResponse(method string, resp interface{}
 
 	responseJSON, err := json.MarshalIndent(resp, "", "  ")
 	if err != nil {
-		log.Printf("Error serializing response for logging: %v", err)
+		slog.Error("Error serializing response for logging", "method", method, "error", err)
 		return
 	}
 
-	log.Printf("RESPONSE [%s]:\n%s", method, string(responseJSON))
+	slog.Debug("Response", "method", method, "body", string(responseJSON))
 }
 
 // ClassifyModels classifies a list of models
 func (h *ModelClassificationHandler) ClassifyModels(ctx context.Context, req *proto.LoadedModelList) (*proto.ClassifiedModelResponse, error) {
 	// h.logRequest("ClassifyModels", req)
+	start := time.Now()
+
+	if err := h.checkModelLimit("ClassifyModels", len(req.Models)); err != nil {
+		return nil, err
+	}
+
+	keepFields, err := modelFieldSet(req.Fields)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// A retry of a completed request gets the original response back
+	if req.RequestId != "" {
+		if completed, ok := h.completed.get(req.RequestId); ok {
+			slog.Debug("Returning completed response for retried request", "request_id", req.RequestId)
+			return maskedResponse(completed, keepFields), nil
+		}
+	}
+
+	// One classifier snapshot for the whole request, so a concurrent reload can't
+	// classify the models with one configuration and group them with another
+	live := h.live.Load()
+
+	// Identical requests produce identical trees under the same classifier, so reuse a
+	// recent response. The key includes the snapshot's generation: a request that
+	// outlives a reload caches its result under the old generation, where no later
+	// request looks.
+	cacheKey := modelListCacheKey(req, live.generation)
+	if cached, ok := h.responses.get(cacheKey); ok && cacheKey != "" {
+		if req.RequestId != "" {
+			h.completed.set(req.RequestId, cached)
+		}
+		return maskedResponse(cached, keepFields), nil
+	}
 
 	// Convert proto models to our internal model representation
 	internalModels := convertProtoModelsToInternal(req.Models)
+	if !req.KeepDuplicates {
+		internalModels = dedupeModels("ClassifyModels", internalModels)
+	}
 
 	// Enhance and classify models with hierarchical structure by default
 	result := &proto.ClassifiedModelResponse{
 		AvailableProperties: convertToProtoProperties(models.AvailableClassificationProperties()),
 	}
 
-	// Enhance models with classification properties
-	enhancedModels := h.enhanceModels(internalModels)
+	// Enhance models with classification properties, unless the client already did
+	summary := models.NewClassificationSummary()
+	enhancedModels := internalModels
+	if req.SkipEnhancement {
+		for _, model := range enhancedModels {
+			summary.Add(model)
+		}
+	} else {
+		enhancedModels = h.enhanceModels(ctx, internalModels, summary, live)
+	}
+	result.Summary = convertSummaryToProto(summary)
 
 	// Build hierarchical model groups by default
-	rootGroups := h.buildModelHierarchy(enhancedModels)
+	rootGroups := h.buildModelHierarchy(ctx, enhancedModels, DefaultHierarchyDimensions, ProviderGroupingOriginal, false, live)
 
 	// Restore original providers AFTER building the hierarchy (which uses classified providers)
 	// but BEFORE converting to proto (so the display shows original providers)
@@ -117,13 +266,31 @@ func (h *ModelClassificationHandler) ClassifyModels(ctx context.Context, req *pr
 		result.HierarchicalGroups = append(result.HierarchicalGroups, protoGroup)
 	}
 
-	// log.Printf("Returning hierarchical classification with %d root groups", len(result.HierarchicalGroups))
+	if cacheKey != "" {
+		h.responses.set(cacheKey, result)
+	}
+	if req.RequestId != "" {
+		h.completed.set(req.RequestId, result)
+	}
+
+	slog.Info("Classified models",
+		"method", "ClassifyModels",
+		"models", len(req.Models),
+		"root_groups", len(result.HierarchicalGroups),
+		"duration", time.Since(start))
 	// h.logResponse("ClassifyModels", result)
-	return result, nil
+	return maskedResponse(result, keepFields), nil
 }
 
 // ClassifyModelsWithCriteria classifies models based on specific criteria
 func (h *ModelClassificationHandler) ClassifyModelsWithCriteria(ctx context.Context, req *proto.ClassificationCriteria) (*proto.ClassifiedModelResponse, error) {
+	start := time.Now()
+
+	// Substitute default criteria so a nil request can't panic
+	if req == nil {
+		req = defaultClassificationCriteria()
+	}
+
 	// log.Printf("Received request to classify models with criteria: %+v", req)
 	// h.logRequest("ClassifyModelsWithCriteria", req)
 
@@ -136,48 +303,122 @@ func (h *ModelClassificationHandler) ClassifyModelsWithCriteria(ctx context.Cont
 	modelsList, err := h.getModelsFromContext(ctx)
 	if err != nil {
 		result.ErrorMessage = err.Error()
-		log.Printf("Error: %s", err.Error())
+		slog.Error("Failed to get models from context", "error", err)
 		return result, nil
 	}
 
+	if err := h.checkModelLimit("ClassifyModelsWithCriteria", len(modelsList)); err != nil {
+		return nil, err
+	}
+
+	// One classifier snapshot for the whole request, so a concurrent reload can't
+	// classify the models with one configuration and group them with another
+	live := h.live.Load()
+	if !req.KeepDuplicates {
+		modelsList = dedupeModels("ClassifyModelsWithCriteria", modelsList)
+	}
+
+	if err := validateSortBy(req.SortBy); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validateFormat(req.Format); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Format == FormatMap && req.SkeletonOnly {
+		return nil, status.Error(codes.InvalidArgument, "format map cannot be combined with skeleton_only")
+	}
+	keepFields, err := modelFieldSet(req.Fields)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.MaxContextSize > 0 && req.MinContextSize > req.MaxContextSize {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"min_context_size (%d) must not exceed max_context_size (%d)", req.MinContextSize, req.MaxContextSize)
+	}
+
 	// Properties to classify by (use from request or default)
 	properties := req.Properties
 	if len(properties) == 0 {
 		properties = DefaultClassificationProperties
 	}
 
+	// A metadata group key adds a dynamic property for the client's own metadata
+	if req.MetadataGroupKey != "" {
+		metadataProperty := PropertyMetadataPrefix + req.MetadataGroupKey
+		if !containsAny(properties, []string{metadataProperty}) {
+			properties = append(append([]string(nil), properties...), metadataProperty)
+		}
+	}
+
 	// Filter models based on criteria
 	filteredModels := h.filterModelsByCriteria(modelsList, req)
 
-	// Enhance models with classification properties
-	enhancedModels := h.enhanceModels(filteredModels)
+	// Enhance models with classification properties. Multimodality and resolved context
+	// windows are only known after classification, so filter and summarize afterwards.
+	summary := models.NewClassificationSummary()
+	var enhancedModels []*models.Model
+	for _, model := range h.enhanceModels(ctx, filteredModels, nil, live) {
+		if req.MultimodalOnly && !model.IsMultimodal {
+			continue
+		}
+		if !withinContextBounds(model, req) {
+			continue
+		}
+		enhancedModels = append(enhancedModels, model)
+		summary.Add(model)
+	}
+	filteredModels = enhancedModels
+	result.Summary = convertSummaryToProto(summary)
 
-	// Default to hierarchical=true unless explicitly set to false
-	useHierarchical := true
-	if req != nil && !req.Hierarchical {
-		useHierarchical = false
+	// Character estimates are opt-in so existing responses stay the same size
+	if req.IncludeCharsEstimate {
+		for _, model := range enhancedModels {
+			model.ContextCharsEstimate = models.EstimateCharacters(model.ContextSize)
+		}
 	}
 
-	// Check if hierarchical classification is requested or defaulted
-	if useHierarchical {
+	// Hierarchical unless explicitly set to false (nil criteria default to hierarchical).
+	// A skeleton is always a hierarchy.
+	useHierarchical := req.Hierarchical || req.SkeletonOnly
+
+	// BothViews fills the hierarchy and the flat groups from the same enhanced models
+	// in one pass; the flat groups keep the hierarchy's sort unless SortBy overrides it
+	if useHierarchical || req.BothViews {
 		// Use hierarchical classification
 		// log.Printf("Using hierarchical classification by provider > type > version") // Removed
-		rootGroups := h.buildModelHierarchy(enhancedModels)
+		dimensions, warning := h.hierarchyDimensions(req)
+		if warning != "" {
+			result.Warnings = append(result.Warnings, warning)
+		}
+		rootGroups := h.buildModelHierarchy(ctx, enhancedModels, dimensions,
+			providerGroupingOrDefault(req.ProviderGrouping, ProviderGroupingOriginal), req.PreferDefaults, live)
 
 		// Restore original providers AFTER building the hierarchy
 		// h.restoreOriginalProviders(enhancedModels) // No longer needed
 
-		// Convert internal root groups to proto format and add to response
-		for _, group := range rootGroups {
-			protoGroup := convertInternalHierarchicalGroupToProto(group)
-			result.HierarchicalGroups = append(result.HierarchicalGroups, protoGroup)
+		if req.Format == FormatMap {
+			// Keyed objects replace the array-of-groups form for JS consumers
+			treeJSON, err := json.Marshal(models.HierarchyToMap(rootGroups))
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "encode tree: %v", err)
+			}
+			result.TreeJson = string(treeJSON)
+		} else {
+			// Convert internal root groups to proto format and add to response
+			for _, group := range rootGroups {
+				protoGroup := convertInternalHierarchicalGroupToProto(group)
+				result.HierarchicalGroups = append(result.HierarchicalGroups, protoGroup)
+			}
 		}
 
 		/* // Removed block
 		log.Printf("Returning hierarchical classification with %d root groups and %d models",
 			len(result.HierarchicalGroups), len(filteredModels))
 		*/
-	} else {
+	}
+
+	if !useHierarchical || req.BothViews {
 		// Use flat classification (original behavior)
 		// Create classification groups for each property
 
@@ -185,8 +426,13 @@ func (h *ModelClassificationHandler) ClassifyModelsWithCriteria(ctx context.Cont
 		// h.restoreOriginalProviders(enhancedModels) // Not needed if Provider field isn't overwritten
 
 		// Create classification groups for each property
+		providerGrouping := providerGroupingOrDefault(req.ProviderGrouping, ProviderGroupingResolved)
+
+		// An explicit sort order replaces the default ordering within each group
+		h.sortModelsBy(enhancedModels, req.SortBy, live)
+
 		for _, property := range properties {
-			groups := h.classifyModelsByProperty(enhancedModels, property)
+			groups := h.classifyModelsByProperty(enhancedModels, property, providerGrouping)
 			result.ClassifiedGroups = append(result.ClassifiedGroups, groups...)
 		}
 
@@ -194,10 +440,294 @@ func (h *ModelClassificationHandler) ClassifyModelsWithCriteria(ctx context.Cont
 		// 	len(result.ClassifiedGroups), len(filteredModels))
 	}
 
+	// Skeletons keep the group structure and counts for navigation, without the models
+	if req.SkeletonOnly {
+		stripGroupModels(result)
+	}
+	maskResponseModels(result, keepFields)
+
+	// Per-model and per-group progress is logged at debug level; keep one summary line at info
+	slog.Info("Classified models",
+		"method", "ClassifyModelsWithCriteria",
+		"models", len(modelsList),
+		"filtered", len(filteredModels),
+		"hierarchical", useHierarchical,
+		"groups", len(result.HierarchicalGroups)+len(result.ClassifiedGroups),
+		"duration", time.Since(start))
 	// h.logResponse("ClassifyModelsWithCriteria", result)
 	return result, nil
 }
 
+// GetMultimodalModels returns the classified tree of only the multimodal models in the list.
+// It is ClassifyModelsWithCriteria with multimodal_only set and nothing else filtered.
+func (h *ModelClassificationHandler) GetMultimodalModels(ctx context.Context, req *proto.LoadedModelList) (*proto.ClassifiedModelResponse, error) {
+	ctx = context.WithValue(ctx, "models", &models.LoadedModelList{
+		Models:          convertProtoModelsToInternal(req.Models),
+		DefaultProvider: req.DefaultProvider,
+		DefaultModel:    req.DefaultModel,
+	})
+
+	return h.ClassifyModelsWithCriteria(ctx, &proto.ClassificationCriteria{
+		Hierarchical:        true,
+		IncludeExperimental: true,
+		IncludeDeprecated:   true,
+		MultimodalOnly:      true,
+		KeepDuplicates:      req.KeepDuplicates,
+	})
+}
+
+// ClassifySingleModel classifies one model by ID without building any groups
+func (h *ModelClassificationHandler) ClassifySingleModel(ctx context.Context, req *proto.SingleModelRequest) (*proto.Model, error) {
+	if err := validateSingleModelRequest(req); err != nil {
+		return nil, err
+	}
+
+	model := h.classifySingle(req)
+	return convertInternalModelsToProto([]*models.Model{model})[0], nil
+}
+
+// CompareModels classifies two models by ID and compares them field by field
+func (h *ModelClassificationHandler) CompareModels(ctx context.Context, req *proto.CompareModelsRequest) (*proto.ModelComparison, error) {
+	if validateSingleModelRequest(req.GetA()) != nil || validateSingleModelRequest(req.GetB()) != nil {
+		return nil, status.Error(codes.InvalidArgument, "both models a and b must have an id")
+	}
+
+	a := h.classifySingle(req.A)
+	b := h.classifySingle(req.B)
+	comparison := models.CompareModels(a, b)
+
+	converted := convertInternalModelsToProto([]*models.Model{a, b})
+	result := &proto.ModelComparison{
+		A:                  converted[0],
+		B:                  converted[1],
+		Fields:             make([]*proto.FieldComparison, 0, len(comparison.Fields)),
+		CommonCapabilities: comparison.CommonCapabilities,
+		OnlyACapabilities:  comparison.OnlyACapabilities,
+		OnlyBCapabilities:  comparison.OnlyBCapabilities,
+	}
+	for _, field := range comparison.Fields {
+		result.Fields = append(result.Fields, &proto.FieldComparison{
+			Field:  field.Field,
+			A:      field.A,
+			B:      field.B,
+			Result: field.Result,
+		})
+	}
+	return result, nil
+}
+
+// validateSingleModelRequest rejects requests without a model ID; there is nothing to classify
+func validateSingleModelRequest(req *proto.SingleModelRequest) error {
+	if strings.TrimSpace(req.GetId()) == "" {
+		return status.Error(codes.InvalidArgument, "model id is required")
+	}
+	return nil
+}
+
+// classifySingle builds and classifies a model from a bare ID and optional provider
+func (h *ModelClassificationHandler) classifySingle(req *proto.SingleModelRequest) *models.Model {
+	model, _ := h.classifySingleWithTrace(req, false)
+	return model
+}
+
+// classifySingleWithTrace is classifySingle that also returns the classification trace
+// when trace is set. Both RPCs go through it so a trace always explains the same result.
+func (h *ModelClassificationHandler) classifySingleWithTrace(req *proto.SingleModelRequest, trace bool) (*models.Model, []classifiers.TraceStep) {
+	model := &models.Model{
+		ID:               req.Id,
+		Name:             req.Id,
+		Provider:         req.Provider,
+		OriginalProvider: req.Provider,
+	}
+
+	normalizeModelProvider(model)
+	live := h.live.Load()
+	var metadata classifiers.ModelMetadata
+	var steps []classifiers.TraceStep
+	if trace {
+		metadata, steps = live.classifier.ClassifyWithNameTrace(model.ID, model.Name, model.Provider)
+	} else {
+		metadata = live.classifier.ClassifyModelWithName(model.ID, model.Name, model.Provider)
+	}
+	h.applyModelMetadata(model, metadata, live)
+	return model, steps
+}
+
+// ClassifyWithTrace classifies one model by ID and reports the rule behind each label
+func (h *ModelClassificationHandler) ClassifyWithTrace(ctx context.Context, req *proto.SingleModelRequest) (*proto.ModelTrace, error) {
+	if err := validateSingleModelRequest(req); err != nil {
+		return nil, err
+	}
+
+	model, steps := h.classifySingleWithTrace(req, true)
+	result := &proto.ModelTrace{
+		Model: convertInternalModelsToProto([]*models.Model{model})[0],
+		Steps: make([]*proto.TraceStep, 0, len(steps)),
+	}
+	for _, step := range steps {
+		result.Steps = append(result.Steps, &proto.TraceStep{
+			Field: step.Field,
+			Value: step.Value,
+			Rule:  step.Rule,
+		})
+	}
+	return result, nil
+}
+
+// GetModelsMetadata returns the classifier metadata for each model without sorting or grouping
+func (h *ModelClassificationHandler) GetModelsMetadata(ctx context.Context, req *proto.LoadedModelList) (*proto.ModelMetadataResponse, error) {
+	if err := h.checkModelLimit("GetModelsMetadata", len(req.Models)); err != nil {
+		return nil, err
+	}
+
+	result := &proto.ModelMetadataResponse{
+		Models: make([]*proto.ModelMetadata, 0, len(req.Models)),
+	}
+
+	classifier := h.classifier()
+	for _, model := range req.Models {
+		metadata := classifier.ClassifyModelWithName(classificationID(model.Id, model.BaseModel), model.Name, model.Provider)
+		result.Models = append(result.Models, convertMetadataToProto(model.Id, metadata))
+	}
+
+	return result, nil
+}
+
+// ValidateModels reports for each submitted ID whether it resolves to a known provider,
+// along with its canonical name, without sorting or grouping
+func (h *ModelClassificationHandler) ValidateModels(ctx context.Context, req *proto.LoadedModelList) (*proto.ValidationResponse, error) {
+	if err := h.checkModelLimit("ValidateModels", len(req.Models)); err != nil {
+		return nil, err
+	}
+
+	result := &proto.ValidationResponse{
+		Models: make([]*proto.ModelValidation, 0, len(req.Models)),
+	}
+
+	classifier := h.classifier()
+	for _, model := range req.Models {
+		metadata := classifier.ClassifyModelWithName(classificationID(model.Id, model.BaseModel), model.Name, model.Provider)
+
+		canonicalName := metadata.DisplayName
+		if canonicalName == "" {
+			canonicalName = metadata.Variant
+		}
+
+		validation := &proto.ModelValidation{
+			Id:            model.Id,
+			Known:         metadata.Provider != classifiers.ProviderOther,
+			Provider:      metadata.Provider,
+			CanonicalName: canonicalName,
+		}
+		if !validation.Known {
+			result.UnknownCount++
+		}
+		result.Models = append(result.Models, validation)
+	}
+
+	return result, nil
+}
+
+// GetModelsByFamily returns the classified models of one family, newest first
+func (h *ModelClassificationHandler) GetModelsByFamily(ctx context.Context, req *proto.FamilyModelsRequest) (*proto.FamilyModelsResponse, error) {
+	if req.Family == "" {
+		return nil, status.Error(codes.InvalidArgument, "family is required")
+	}
+	if err := h.checkModelLimit("GetModelsByFamily", len(req.Models)); err != nil {
+		return nil, err
+	}
+
+	live := h.live.Load()
+	enhancedModels := h.enhanceModels(ctx, convertProtoModelsToInternal(req.Models), nil, live)
+
+	// Expose snapshot dates as release dates so dated snapshots order correctly
+	for _, model := range enhancedModels {
+		if date := releaseDate(model, live); date != "" && model.Metadata["release_date"] == "" {
+			if model.Metadata == nil {
+				model.Metadata = make(map[string]string)
+			}
+			model.Metadata["release_date"] = date
+		}
+	}
+
+	familyModels := models.ModelsByFamily(enhancedModels, req.Family)
+	return &proto.FamilyModelsResponse{
+		Models: convertInternalModelsToProto(familyModels),
+	}, nil
+}
+
+// GetProvidersSummary returns per-provider model counts and context coverage
+func (h *ModelClassificationHandler) GetProvidersSummary(ctx context.Context, req *proto.LoadedModelList) (*proto.ProvidersSummaryResponse, error) {
+	if err := h.checkModelLimit("GetProvidersSummary", len(req.Models)); err != nil {
+		return nil, err
+	}
+
+	modelsList := convertProtoModelsToInternal(req.Models)
+	if !req.KeepDuplicates {
+		modelsList = dedupeModels("GetProvidersSummary", modelsList)
+	}
+	enhancedModels := h.enhanceModels(ctx, modelsList, nil, h.live.Load())
+
+	result := &proto.ProvidersSummaryResponse{}
+	for _, summary := range models.SummarizeProviders(enhancedModels) {
+		result.Providers = append(result.Providers, &proto.ProviderSummary{
+			Provider:        summary.Provider,
+			ModelCount:      summary.ModelCount,
+			MultimodalCount: summary.MultimodalCount,
+			DefaultCount:    summary.DefaultCount,
+			MinContextSize:  summary.MinContextSize,
+			MaxContextSize:  summary.MaxContextSize,
+			FamilyCount:     summary.FamilyCount,
+		})
+	}
+	return result, nil
+}
+
+// RecommendModel recommends the cheapest classified model satisfying the request constraints
+func (h *ModelClassificationHandler) RecommendModel(ctx context.Context, req *proto.RecommendationRequest) (*proto.RecommendationResponse, error) {
+	if err := h.checkModelLimit("RecommendModel", len(req.Models)); err != nil {
+		return nil, err
+	}
+
+	result := &proto.RecommendationResponse{}
+
+	// Enhance models so capabilities and context sizes are available for matching
+	enhancedModels := h.enhanceModels(ctx, convertProtoModelsToInternal(req.Models), nil, h.live.Load())
+
+	recommended, err := models.RecommendModel(models.RecommendationRequest{
+		Models:                  enhancedModels,
+		RequiredCapabilities:    req.RequiredCapabilities,
+		MinContextSize:          req.MinContextSize,
+		MaxInputPricePerMillion: req.MaxInputPricePerMillion,
+	})
+	if err != nil {
+		result.ErrorMessage = err.Error()
+		return result, nil
+	}
+
+	result.Model = convertInternalModelsToProto([]*models.Model{recommended})[0]
+	return result, nil
+}
+
+// GetClassificationProperties returns the available classification properties
+func (h *ModelClassificationHandler) GetClassificationProperties(ctx context.Context, req *proto.ClassificationPropertiesRequest) (*proto.ClassificationPropertiesResponse, error) {
+	return &proto.ClassificationPropertiesResponse{
+		Properties: convertToProtoProperties(models.AvailableClassificationProperties()),
+	}, nil
+}
+
+// GetServerInfo returns the running build's version, uptime and classifier statistics
+func (h *ModelClassificationHandler) GetServerInfo(ctx context.Context, req *proto.ServerInfoRequest) (*proto.ServerInfoResponse, error) {
+	return &proto.ServerInfoResponse{
+		Version:            version.Version,
+		Commit:             version.Commit,
+		BuildDate:          version.BuildDate,
+		UptimeSeconds:      int64(time.Since(h.startTime).Seconds()),
+		PatternCount:       int32(h.classifier().PatternCount()),
+		RegistryModelCount: int32(h.classifier().RegistryModelCount()),
+	}, nil
+}
+
 // getModelsFromContext extracts and validates models from the context
 func (h *ModelClassificationHandler) getModelsFromContext(ctx context.Context) ([]*models.Model, error) {
 	modelCtx := ctx.Value("models")
@@ -214,55 +744,85 @@ func (h *ModelClassificationHandler) getModelsFromContext(ctx context.Context) (
 }
 
 // buildClassificationResponse creates a full classification response for the given models and properties
-func (h *ModelClassificationHandler) buildClassificationResponse(modelsList []*models.Model, properties []string) *proto.ClassifiedModelResponse {
+func (h *ModelClassificationHandler) buildClassificationResponse(ctx context.Context, modelsList []*models.Model, properties []string) *proto.ClassifiedModelResponse {
 	// Create response with available properties
 	result := &proto.ClassifiedModelResponse{
 		AvailableProperties: convertToProtoProperties(models.AvailableClassificationProperties()),
 	}
 
 	// Enhance models with classification properties
-	enhancedModels := h.enhanceModels(modelsList)
+	enhancedModels := h.enhanceModels(ctx, modelsList, nil, h.live.Load())
 
 	// Create classification groups for each property
 	for _, property := range properties {
-		groups := h.classifyModelsByProperty(enhancedModels, property)
+		groups := h.classifyModelsByProperty(enhancedModels, property, ProviderGroupingResolved)
 		result.ClassifiedGroups = append(result.ClassifiedGroups, groups...)
 	}
 
 	return result
 }
 
-// enhanceModels enhances models with classification properties
-func (h *ModelClassificationHandler) enhanceModels(modelsList []*models.Model) []*models.Model {
-	// log.Printf("[DEBUG] Starting model enhancement for %d models...", len(modelsList)) // Removed
+// classificationID returns the ID a model is classified by: fine-tuned models take
+// their labels from the base model, since fine-tune IDs carry no reliable pattern
+func classificationID(modelID, baseModel string) string {
+	if baseModel != "" {
+		return baseModel
+	}
+	return modelID
+}
+
+// enhanceModels enhances models with classification properties using the classifier
+// snapshot live, accumulating statistics into summary in the same pass when it is non-nil
+func (h *ModelClassificationHandler) enhanceModels(ctx context.Context, modelsList []*models.Model, summary *models.ClassificationSummary, live *classifierState) []*models.Model {
+	_, span := tracer.Start(ctx, "enhanceModels")
+	span.SetAttributes(attribute.Int("models.count", len(modelsList)))
+	defer span.End()
+
+	start := time.Now()
+	slog.Debug("Starting model enhancement", "models", len(modelsList))
 	for i, model := range modelsList {
-		// Use the unified ClassifyModel method to get all metadata at once
-		metadata := h.classifier.ClassifyModel(model.ID, model.Provider)
-		h.applyModelMetadata(model, metadata)
+		normalizeModelProvider(model)
+
+		// Use the unified ClassifyModel method to get all metadata at once, falling
+		// back to a "Provider: Model" display name when the ID isn't recognized
+		metadata := live.classifier.ClassifyModelWithName(classificationID(model.ID, model.BaseModel), model.Name, model.Provider)
+		h.applyModelMetadata(model, metadata, live)
+		if summary != nil {
+			summary.Add(model)
+		}
 		if i%10 == 0 && i > 0 {
-			// log.Printf("[DEBUG] Enhanced %d/%d models...", i, len(modelsList)) // Removed
+			slog.Debug("Enhanced models", "done", i, "total", len(modelsList))
 		}
 	}
-	// log.Printf("[DEBUG] Finished model enhancement for %d models.", len(modelsList)) // Removed
+	slog.Debug("Finished model enhancement", "models", len(modelsList), "duration", time.Since(start))
 	return modelsList
 }
 
-// applyModelMetadata applies the classification metadata to a model
-func (h *ModelClassificationHandler) applyModelMetadata(model *models.Model, metadata classifiers.ModelMetadata) {
+// applyModelMetadata applies the classification metadata to a model, using the
+// classifier snapshot the metadata came from
+func (h *ModelClassificationHandler) applyModelMetadata(model *models.Model, metadata classifiers.ModelMetadata, live *classifierState) {
 	// Save the original provider before updating
 	originalProvider := model.OriginalProvider
 
 	// Always overwrite with classifier results to ensure consistency
 	model.Provider = metadata.Provider // Also ensure provider is consistent
-	
+
 	// Preserve original provider
 	model.OriginalProvider = originalProvider
-	
-	model.Family = metadata.Series
-	model.Type = metadata.Type
-	model.Series = metadata.Series // Assuming Family and Series are the same here based on previous logic
+
+	// Client-supplied family and type beat the classifier's "other" fallback
+	unrecognized := metadata.Provider == classifiers.ProviderOther
+	if !unrecognized || model.Family == "" {
+		model.Family = metadata.Family
+		model.RawFamily = metadata.RawFamily
+	}
+	model.FamilyDisplayName = live.familyDisplayName(model.Family)
+	if !unrecognized || model.Type == "" {
+		model.Type = metadata.Type
+	}
+	model.Series = metadata.Series
 	model.Variant = metadata.Variant
-	
+
 	// Sort capabilities alphabetically
 	capabilities := metadata.Capabilities
 	if len(capabilities) > 0 {
@@ -272,41 +832,106 @@ func (h *ModelClassificationHandler) applyModelMetadata(model *models.Model, met
 	}
 	model.Capabilities = capabilities
 
+	// Effort selector levels for reasoning models; empty for everything else
+	model.ReasoningTiers = classifiers.ReasoningTiers(model.ID)
+
 	// Set version information if it's not already set
 	if model.Version == "" {
 		// Extract standardized version number from model ID and variant
-		standardizedVersion := h.classifier.GetStandardizedVersion(model.ID)
+		standardizedVersion := live.classifier.GetStandardizedVersion(model.ID)
 		if standardizedVersion != "" {
 			model.Version = standardizedVersion
 		}
 	}
 
+	// Use registry pricing when the client didn't provide any
+	if model.CostPerToken == 0 {
+		model.CostPerToken = metadata.CostPerToken
+	}
+
+	// Preserve the raw preview date stripped from the variant
+	if metadata.PreviewDate != "" {
+		if model.Metadata == nil {
+			model.Metadata = make(map[string]string)
+		}
+		model.Metadata["preview_date"] = metadata.PreviewDate
+	}
+
+	// Record quantization detected from the model ID if not provided
+	if model.Quantization == "" {
+		model.Quantization = metadata.Quantization
+	}
+
+	// Keep the routing suffix for display; the classification describes the base model
+	if model.RoutingVariant == "" {
+		model.RoutingVariant = metadata.RoutingVariant
+	}
+
+	// Record the inferred license if not provided
+	if model.License == "" {
+		model.License = metadata.License
+	}
+	model.IsOpenWeight = model.License == classifiers.LicenseOpen
+
+	if model.Tuning == "" {
+		model.Tuning = metadata.Tuning
+	}
+
+	model.Tags = normalizeTags(model.Tags, model.Metadata)
+	model.Confidence = metadata.Confidence
+
+	// Preserve the teacher a distilled model came from
+	model.IsDistilled = metadata.IsDistilled
+	if metadata.DistilledFrom != "" {
+		if model.Metadata == nil {
+			model.Metadata = make(map[string]string)
+		}
+		model.Metadata["distilled_from"] = metadata.DistilledFrom
+	}
+
+	if len(model.InputModalities) == 0 {
+		model.InputModalities = metadata.InputModalities
+	}
+	if len(model.OutputModalities) == 0 {
+		model.OutputModalities = metadata.OutputModalities
+	}
+
 	// Set multimodal flag based on metadata and other checks
+	// Image generation is an output modality, so the name-based vision fallbacks
+	// (e.g. "gemini" in a Gemini image model) don't apply to it. Recognized models
+	// rely on the classifier, which knows text-only variants such as gpt-4-0314.
 	model.IsMultimodal = metadata.IsMultimodal ||
-		containsAny(model.Capabilities, []string{"vision", "multimodal"}) ||
-		strings.Contains(strings.ToLower(model.ID), "vision") ||
-		strings.Contains(strings.ToLower(model.ID), "gpt-4") ||
-		strings.Contains(strings.ToLower(model.ID), "claude-3") ||
-		strings.Contains(strings.ToLower(model.ID), "gemini")
+		containsAny(model.Capabilities, []string{"vision", "multimodal"})
+	if model.Type != classifiers.TypeImage && metadata.Provider == classifiers.ProviderOther {
+		model.IsMultimodal = model.IsMultimodal ||
+			strings.Contains(strings.ToLower(model.ID), "vision") ||
+			strings.Contains(strings.ToLower(model.ID), "gpt-4") ||
+			strings.Contains(strings.ToLower(model.ID), "claude-3") ||
+			strings.Contains(strings.ToLower(model.ID), "gemini")
+	}
 
-	// Set experimental flag based on metadata and name patterns
-	model.IsExperimental = metadata.IsExperimental || // Base on classifier result first
-		strings.Contains(strings.ToLower(model.ID), "preview") ||
-		strings.Contains(strings.ToLower(model.ID), "experimental")
+	// The classifier covers the name patterns and any configured overrides
+	model.IsExperimental = metadata.IsExperimental
 
 	// Check if model is a default one
-	model.IsDefault = h.classifier.IsDefaultModelName(model.ID)
+	model.IsDefault = live.classifier.IsDefaultModelName(model.ID)
 	// only override DisplayName if not already set in the request
+	model.IsFineTuned = model.IsFineTuned || model.BaseModel != ""
 	if model.DisplayName == "" {
-		if metadata.DisplayName != "" {
-			model.DisplayName = metadata.DisplayName
+		if model.BaseModel != "" {
+			model.DisplayName = classifiers.FormatDisplayName(model.BaseModel, metadata) + " (fine-tuned)"
 		} else {
-			model.DisplayName = strings.ReplaceAll(model.ID, "-", " ")
+			model.DisplayName = classifiers.FormatDisplayName(model.ID, metadata)
 		}
 	}
-	
-	// Only set context size for Gemini models
-	if strings.EqualFold(model.Provider, "gemini") || strings.Contains(strings.ToLower(model.ID), "gemini") {
+
+	// Registry context sizes are authoritative for any provider
+	if model.ContextSize == 0 && metadata.RegistryMatch && metadata.Context > 0 {
+		model.ContextSize = int32(metadata.Context)
+	}
+
+	// Otherwise only set context size for Google models (Gemini, Gemma and Imagen alike)
+	if metadata.Provider == classifiers.ProviderGoogle {
 		if model.ContextSize == 0 && len(model.ID) > 0 {
 			// Check for standard size in map
 			if size, exists := StandardContextSizes[model.ID]; exists {
@@ -316,46 +941,25 @@ func (h *ModelClassificationHandler) applyModelMetadata(model *models.Model, met
 			}
 		}
 	}
+
+	// Anything still unknown takes the classifier's estimate, which falls back to a
+	// per-provider default so unrecognized chat models don't land in the smallest bucket
+	if model.ContextSize == 0 && metadata.Context > 0 {
+		model.ContextSize = int32(metadata.Context)
+	}
 }
 
 // classifyModelsByProperty classifies models based on a specific property
-func (h *ModelClassificationHandler) classifyModelsByProperty(modelsList []*models.Model, property string) []*proto.ClassifiedModelGroup {
+func (h *ModelClassificationHandler) classifyModelsByProperty(modelsList []*models.Model, property, providerGrouping string) []*proto.ClassifiedModelGroup {
 	var groups []*proto.ClassifiedModelGroup
 	propertyGroups := make(map[string][]*models.Model)
 
 	for _, model := range modelsList {
-		var propertyValue string
-
-		switch property {
-		case PropertyProvider:
-			propertyValue = model.Provider
-		case PropertyFamily:
-			propertyValue = model.Family
-		case PropertyType:
-			propertyValue = model.Type
-		case PropertySeries:
-			propertyValue = model.Series
-		case PropertyVariant:
-			propertyValue = model.Variant
-		case PropertyCapability:
-			// For capabilities, create a group for each capability
-			for _, capability := range model.Capabilities {
-				if len(capability) > 0 {
-					propertyGroups[capability] = append(propertyGroups[capability], model)
-				}
+		// Multi-valued properties such as capability put the model in a group per value
+		for _, propertyValue := range modelPropertyValues(model, property, providerGrouping) {
+			if propertyValue != "" {
+				propertyGroups[propertyValue] = append(propertyGroups[propertyValue], model)
 			}
-			continue
-		case PropertyContextWindow:
-			propertyValue = h.categorizeContextWindow(model.ContextSize)
-		case PropertyMultimodal:
-			propertyValue = h.boolToYesNo(model.IsMultimodal)
-		default:
-			// Skip unknown properties
-			continue
-		}
-
-		if propertyValue != "" {
-			propertyGroups[propertyValue] = append(propertyGroups[propertyValue], model)
 		}
 	}
 
@@ -379,8 +983,110 @@ func (h *ModelClassificationHandler) classifyModelsByProperty(modelsList []*mode
 	return groups
 }
 
+// modelPropertyValues returns a model's values for a classification property.
+// Capability is multi-valued; unknown properties yield no values.
+func modelPropertyValues(model *models.Model, property, providerGrouping string) []string {
+	switch property {
+	case PropertyProvider:
+		return []string{groupingProvider(model, providerGrouping)}
+	case PropertyFamily:
+		return []string{model.Family}
+	case PropertyType:
+		return []string{model.Type}
+	case PropertySeries:
+		return []string{model.Series}
+	case PropertyVariant:
+		return []string{model.Variant}
+	case PropertyCapability:
+		return model.Capabilities
+	case PropertyContextWindow:
+		return []string{categorizeContextWindow(model.ContextSize)}
+	case PropertyMultimodal:
+		return []string{boolToYesNo(model.IsMultimodal)}
+	case PropertyQuantization:
+		return []string{model.Quantization}
+	case PropertyLicense:
+		return []string{model.License}
+	case PropertyTuning:
+		return []string{model.Tuning}
+	case PropertyInputModality:
+		return model.InputModalities
+	case PropertyOutputModality:
+		return model.OutputModalities
+	case PropertyTag:
+		return model.Tags
+	case PropertyDistilled:
+		return []string{boolToYesNo(model.IsDistilled)}
+	default:
+		if key := strings.TrimPrefix(property, PropertyMetadataPrefix); key != property && key != "" {
+			// Models without the key get no value and are left out of the groups
+			if value := model.Metadata[key]; value != "" {
+				return []string{value}
+			}
+		}
+		return nil
+	}
+}
+
+// hierarchyDimensions returns the hierarchy levels for a request: explicit
+// HierarchyDimensions win, then the Properties list in order, then the default.
+// Levels beyond the depth cap (the request's MaxHierarchyDepth, never above the
+// server's) are dropped so their models stay in the deepest group, and a warning
+// describing the truncation is returned.
+func (h *ModelClassificationHandler) hierarchyDimensions(req *proto.ClassificationCriteria) ([]string, string) {
+	dimensions := DefaultHierarchyDimensions
+	if len(req.HierarchyDimensions) > 0 {
+		dimensions = req.HierarchyDimensions
+	} else if len(req.Properties) > 0 {
+		dimensions = req.Properties
+	}
+
+	maxDepth := h.maxHierarchyDepth
+	if req.MaxHierarchyDepth > 0 && (maxDepth <= 0 || int(req.MaxHierarchyDepth) < maxDepth) {
+		maxDepth = int(req.MaxHierarchyDepth)
+	}
+	if maxDepth <= 0 || len(dimensions) <= maxDepth {
+		return dimensions, ""
+	}
+
+	return dimensions[:maxDepth], fmt.Sprintf("hierarchy depth capped at %d; dimensions %s were not split",
+		maxDepth, strings.Join(dimensions[maxDepth:], ", "))
+}
+
+// hierarchyValues returns the values a model is grouped under for a hierarchy dimension,
+// substituting a default so every model has a place in the tree
+func hierarchyValues(model *models.Model, dimension, providerGrouping string) []string {
+	var values []string
+	for _, value := range modelPropertyValues(model, dimension, providerGrouping) {
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	if len(values) > 0 {
+		return values
+	}
+
+	switch dimension {
+	case PropertyType:
+		return []string{classifiers.TypeStandard}
+	case PropertyVariant:
+		return []string{"Default"}
+	default:
+		return []string{"Other"}
+	}
+}
+
+// hierarchyGroupName returns the group name used for a hierarchy dimension.
+// Variant levels keep the "version" name clients already rely on.
+func hierarchyGroupName(dimension string) string {
+	if dimension == PropertyVariant {
+		return "version"
+	}
+	return dimension
+}
+
 // categorizeContextWindow categorizes a context window size into a human-readable category
-func (h *ModelClassificationHandler) categorizeContextWindow(size int32) string {
+func categorizeContextWindow(size int32) string {
 	if size <= 10000 {
 		return "Small (< 10K)"
 	} else if size <= 100000 {
@@ -392,20 +1098,34 @@ func (h *ModelClassificationHandler) categorizeContextWindow(size int32) string
 }
 
 // boolToYesNo converts a boolean to a "Yes" or "No" string
-func (h *ModelClassificationHandler) boolToYesNo(value bool) string {
+func boolToYesNo(value bool) string {
 	if value {
 		return "Yes"
 	}
 	return "No"
 }
 
-// filterModelsByCriteria filters models based on classification criteria
+// defaultClassificationCriteria returns the criteria used when a request provides none
+func defaultClassificationCriteria() *proto.ClassificationCriteria {
+	return &proto.ClassificationCriteria{
+		Properties:   DefaultClassificationProperties,
+		Hierarchical: true,
+	}
+}
+
+// filterModelsByCriteria filters models based on the classification criteria known
+// before classification; context-size bounds are applied afterwards by withinContextBounds
 func (h *ModelClassificationHandler) filterModelsByCriteria(modelsList []*models.Model, criteria *proto.ClassificationCriteria) []*models.Model {
+	if criteria == nil {
+		criteria = defaultClassificationCriteria()
+	}
+
 	var result []*models.Model
 
 	for _, model := range modelsList {
 		// Skip models that don't meet the criteria
-		if criteria.MinContextSize > 0 && model.ContextSize < criteria.MinContextSize {
+		if len(criteria.FilterByTags) > 0 &&
+			!matchesTags(normalizeTags(model.Tags, model.Metadata), criteria.FilterByTags, criteria.MatchAllTags) {
 			continue
 		}
 
@@ -426,13 +1146,32 @@ func (h *ModelClassificationHandler) filterModelsByCriteria(modelsList []*models
 	return result
 }
 
-// sortModels sorts a list of models according to specified provider and model hierarchy
-func (h *ModelClassificationHandler) sortModels(modelsList []*models.Model) {
+// withinContextBounds reports whether a classified model's context size lies in the
+// criteria's inclusive [MinContextSize, MaxContextSize] range; zero bounds are unset
+func withinContextBounds(model *models.Model, criteria *proto.ClassificationCriteria) bool {
+	if criteria.MinContextSize > 0 && model.ContextSize < criteria.MinContextSize {
+		return false
+	}
+	if criteria.MaxContextSize > 0 && model.ContextSize > criteria.MaxContextSize {
+		return false
+	}
+	return true
+}
+
+// sortModels sorts a list of models according to specified provider and model hierarchy,
+// clustering models by the provider they will be grouped under. With preferDefaults,
+// IsDefault models come first among models of the same provider and type.
+func (h *ModelClassificationHandler) sortModels(ctx context.Context, modelsList []*models.Model, providerGrouping string, preferDefaults bool) {
+	_, span := tracer.Start(ctx, "sortModels")
+	span.SetAttributes(attribute.Int("models.count", len(modelsList)))
+	defer span.End()
+
 	// Pre-parse models to avoid redundant computations
 	type modelInfo struct {
 		model      *models.Model
 		lowerName  string
 		provider   string
+		family     string
 		modelType  string
 		version    string
 		versionNum float64 // Numeric version for comparison
@@ -440,10 +1179,10 @@ func (h *ModelClassificationHandler) sortModels(modelsList []*models.Model) {
 
 	// Provider priority map
 	providerPriority := map[string]int{
-		"gemini":    0,
-		"openai":    1,
-		"anthropic": 2,
-		"claude":    2, // Treat claude same as anthropic
+		classifiers.ProviderGoogle: 0, // Every Google family sorts in this one bucket
+		"openai":                   1,
+		"anthropic":                2,
+		"claude":                   2, // Treat claude same as anthropic
 	}
 
 	// Type priority maps for each provider
@@ -476,7 +1215,7 @@ func (h *ModelClassificationHandler) sortModels(modelsList []*models.Model) {
 	modelInfos := make([]modelInfo, len(modelsList))
 	for i, model := range modelsList {
 		lowerName := strings.ToLower(model.Name)
-		provider := strings.ToLower(model.Provider)
+		provider := strings.ToLower(groupingProvider(model, providerGrouping))
 		modelType := model.Type
 
 		// Extract version as float for comparison
@@ -495,19 +1234,11 @@ func (h *ModelClassificationHandler) sortModels(modelsList []*models.Model) {
 			}
 		}
 
-		// Special cases for OpenAI mini series
-		if provider == "openai" {
-			if strings.Contains(lowerName, "mini") {
-				modelType = classifiers.TypeMini
-			} else if lowerName[0] == 'o' {
-				modelType = classifiers.TypeO
-			}
-		}
-
 		modelInfos[i] = modelInfo{
 			model:      model,
 			lowerName:  lowerName,
 			provider:   provider,
+			family:     strings.ToLower(model.Family),
 			modelType:  modelType,
 			version:    model.Version,
 			versionNum: versionNum,
@@ -534,9 +1265,14 @@ func (h *ModelClassificationHandler) sortModels(modelsList []*models.Model) {
 			return provPriorityA < provPriorityB
 		}
 
+		// Pin default models ahead of their siblings before any type-specific tie-breakers
+		if preferDefaults && a.modelType == b.modelType && a.model.IsDefault != b.model.IsDefault {
+			return a.model.IsDefault
+		}
+
 		// 2. Secondary sort: Model type/hierarchy (within each provider)
 		switch a.provider {
-		case "gemini":
+		case classifiers.ProviderGoogle:
 			typeA := geminiTypePriority[a.modelType]
 			typeB := geminiTypePriority[b.modelType]
 
@@ -647,6 +1383,21 @@ func (h *ModelClassificationHandler) sortModels(modelsList []*models.Model) {
 			if typeA != typeB {
 				return typeA < typeB
 			}
+
+		default:
+			// Unprioritized providers: cluster by provider, then family, then type so
+			// related models stay together instead of scattering alphabetically
+			if provPriorityA == 100 {
+				if a.provider != b.provider {
+					return a.provider < b.provider
+				}
+				if a.family != b.family {
+					return a.family < b.family
+				}
+				if a.modelType != b.modelType {
+					return a.modelType < b.modelType
+				}
+			}
 		}
 
 		// 3. Tertiary sort: Version number (highest first)
@@ -664,92 +1415,197 @@ func (h *ModelClassificationHandler) sortModels(modelsList []*models.Model) {
 	}
 }
 
-// buildModelHierarchy creates a hierarchical grouping of models by provider, type, and version,
-// preserving the order established by sortModels.
-func (h *ModelClassificationHandler) buildModelHierarchy(modelsList []*models.Model) []*models.HierarchicalModelGroup {
-	// log.Printf("[DEBUG] buildModelHierarchy: Received %d models to build hierarchy.", len(modelsList)) // Removed
+// buildModelHierarchy creates a hierarchical grouping of models along the given dimensions
+// (provider > type > variant by default), preserving the order established by sortModels.
+// Multi-valued dimensions such as capability fan out, placing a model under each of its values.
+// providerGrouping selects whether provider levels use the original (aggregator) provider
+// or the resolved sub-provider; preferDefaults pins default models first (see sortModels).
+// live is the classifier snapshot the models were enhanced with.
+func (h *ModelClassificationHandler) buildModelHierarchy(ctx context.Context, modelsList []*models.Model, dimensions []string, providerGrouping string, preferDefaults bool, live *classifierState) []*models.HierarchicalModelGroup {
+	ctx, span := tracer.Start(ctx, "buildModelHierarchy")
+	span.SetAttributes(attribute.Int("models.count", len(modelsList)))
+	defer span.End()
+
+	start := time.Now()
+	slog.Debug("Building model hierarchy", "models", len(modelsList), "dimensions", dimensions)
+
+	if len(dimensions) == 0 {
+		dimensions = DefaultHierarchyDimensions
+	}
+	// Cap the depth so fan-out dimensions can't grow the tree without bound
+	if h.maxHierarchyDepth > 0 && len(dimensions) > h.maxHierarchyDepth {
+		dimensions = dimensions[:h.maxHierarchyDepth]
+	}
 
 	// 1. Sort models according to the specified criteria FIRST.
-	h.sortModels(modelsList)
-	// log.Printf("[DEBUG] buildModelHierarchy: Finished sorting %d models.", len(modelsList)) // Removed
+	h.sortModels(ctx, modelsList, providerGrouping, preferDefaults)
+	slog.Debug("Sorted models for hierarchy", "models", len(modelsList), "duration", time.Since(start))
 
-	// 2. Build the hierarchy in a single pass over the sorted list.
-	var rootGroups []*models.HierarchicalModelGroup
+	// 2. Build each level by grouping in order of first appearance in the sorted list.
+	rootGroups := h.buildHierarchyLevel(modelsList, dimensions, providerGrouping, live)
+	if len(rootGroups) == 0 {
+		slog.Debug("No models to build hierarchy for")
+	}
+
+	slog.Debug("Finished building hierarchy",
+		"models", len(modelsList),
+		"root_groups", len(rootGroups),
+		"duration", time.Since(start))
+	return rootGroups
+}
+
+// buildHierarchyLevel groups models by the first dimension and recurses into the rest.
+// Leaf groups hold the models, with dated snapshots grouped under their alias.
+func (h *ModelClassificationHandler) buildHierarchyLevel(modelsList []*models.Model, dimensions []string, providerGrouping string, live *classifierState) []*models.HierarchicalModelGroup {
+	var groups []*models.HierarchicalModelGroup
 	if len(modelsList) == 0 {
-		// log.Printf("[DEBUG] buildModelHierarchy: No models to build hierarchy for.") // Removed
-		return rootGroups
+		return groups
 	}
 
-	var currentProviderGroup *models.HierarchicalModelGroup
-	var currentTypeGroup *models.HierarchicalModelGroup
-	var currentVersionGroup *models.HierarchicalModelGroup
+	dimension := dimensions[0]
+	groupIndex := make(map[string]*models.HierarchicalModelGroup)
+	groupModels := make(map[string][]*models.Model)
 
-	for i, model := range modelsList {
-		// Determine provider, type, and version/variant for the current model
-		// Use OriginalProvider for top-level grouping
-		provider := model.OriginalProvider // Changed from model.Provider
-		if provider == "" {
-			// Fallback if OriginalProvider is somehow empty
-			provider = model.Provider
-			if provider == "" {
-				provider = "Other"
+	for _, model := range modelsList {
+		for _, value := range hierarchyValues(model, dimension, providerGrouping) {
+			if _, exists := groupIndex[value]; !exists {
+				slog.Debug("Creating hierarchy group", "dimension", dimension, "value", value)
+				group := &models.HierarchicalModelGroup{
+					GroupName:  hierarchyGroupName(dimension),
+					GroupValue: value,
+				}
+				groupIndex[value] = group
+				groups = append(groups, group)
 			}
+			groupModels[value] = append(groupModels[value], model)
 		}
-		modelType := model.Type
-		if modelType == "" {
-			modelType = classifiers.TypeStandard // Default if empty
+	}
+
+	for _, group := range groups {
+		members := groupModels[group.GroupValue]
+		if len(dimensions) > 1 {
+			group.Children = h.buildHierarchyLevel(members, dimensions[1:], providerGrouping, live)
+			continue
 		}
-		version := model.Variant // Use Variant for the lowest level grouping
-		if version == "" {
-			version = "Default"
+		group.Models = members
+		groupSnapshotsByAlias(group, live.classifier)
+	}
+
+	return groups
+}
+
+// groupSnapshotsByAlias moves dated snapshots and their alias model into an "alias"
+// child group, marking the alias as the default. Models without snapshots stay in place.
+func groupSnapshotsByAlias(versionGroup *models.HierarchicalModelGroup, classifier *classifiers.ModelClassifier) {
+	// Find aliases that have at least one dated snapshot in this group
+	snapshotAliases := make(map[string]bool)
+	for _, model := range versionGroup.Models {
+		if alias, date := classifier.ResolveSnapshot(model.ID); date != "" {
+			snapshotAliases[alias] = true
 		}
+	}
+	if len(snapshotAliases) == 0 {
+		return
+	}
 
-		// Check if Provider changed or if it's the first model
-		if i == 0 || currentProviderGroup == nil || provider != currentProviderGroup.GroupValue {
-			// log.Printf("[DEBUG] buildModelHierarchy: Creating new provider group: %s", provider) // Removed
-			currentProviderGroup = &models.HierarchicalModelGroup{
-				GroupName:  "provider",
-				GroupValue: provider,
-				Children:   []*models.HierarchicalModelGroup{},
-			}
-			rootGroups = append(rootGroups, currentProviderGroup)
-			currentTypeGroup = nil    // Reset type group when provider changes
-			currentVersionGroup = nil // Reset version group when provider changes
-		}
-
-		// Check if Type changed or if it's the first model in this provider group
-		if currentTypeGroup == nil || modelType != currentTypeGroup.GroupValue {
-			// log.Printf("[DEBUG] buildModelHierarchy:   Creating new type group: %s (under %s)", modelType, provider) // Removed
-			currentTypeGroup = &models.HierarchicalModelGroup{
-				GroupName:  "type",
-				GroupValue: modelType,
-				Children:   []*models.HierarchicalModelGroup{},
-			}
-			currentProviderGroup.Children = append(currentProviderGroup.Children, currentTypeGroup)
-			currentVersionGroup = nil // Reset version group when type changes
+	var remaining []*models.Model
+	aliasGroups := make(map[string]*models.HierarchicalModelGroup)
+	for _, model := range versionGroup.Models {
+		alias, date := classifier.ResolveSnapshot(model.ID)
+		if !snapshotAliases[alias] {
+			remaining = append(remaining, model)
+			continue
 		}
 
-		// Check if Version/Variant changed or if it's the first model in this type group
-		if currentVersionGroup == nil || version != currentVersionGroup.GroupValue {
-			// log.Printf("[DEBUG] buildModelHierarchy:     Creating new version group: %s (under %s > %s)", version, provider, modelType) // Removed
-			currentVersionGroup = &models.HierarchicalModelGroup{
-				GroupName:  "version", // Corresponds to Variant in the model
-				GroupValue: version,
-				Models:     []*models.Model{}, // Initialize empty model slice
+		// The undated alias points at the current snapshot, so it is the default
+		if date == "" {
+			model.IsDefault = true
+		}
+
+		aliasGroup, exists := aliasGroups[alias]
+		if !exists {
+			aliasGroup = &models.HierarchicalModelGroup{
+				GroupName:  "alias",
+				GroupValue: alias,
+				Models:     []*models.Model{},
 			}
-			currentTypeGroup.Children = append(currentTypeGroup.Children, currentVersionGroup)
+			aliasGroups[alias] = aliasGroup
+			versionGroup.Children = append(versionGroup.Children, aliasGroup)
 		}
+		aliasGroup.Models = append(aliasGroup.Models, model)
+	}
+	versionGroup.Models = remaining
+}
+
+// Helper Functions
 
-		// Add the model to the current version group
-		// log.Printf("[DEBUG] buildModelHierarchy:       Adding model '%s' to version group '%s'", model.Name, version)
-		currentVersionGroup.Models = append(currentVersionGroup.Models, model)
+// providerGroupingOrDefault returns the requested provider grouping, or the fallback if unset or unknown
+func providerGroupingOrDefault(grouping, fallback string) string {
+	switch grouping {
+	case ProviderGroupingOriginal, ProviderGroupingResolved:
+		return grouping
+	default:
+		return fallback
 	}
+}
 
-	// log.Printf("[DEBUG] buildModelHierarchy: Finished building hierarchy, returning %d root groups.", len(rootGroups)) // Removed
-	return rootGroups
+// normalizeTags merges explicit tags with a comma-separated metadata["tags"] value as
+// forwarded from provider payloads. Tags are lowercased and de-duplicated.
+func normalizeTags(explicit []string, metadata map[string]string) []string {
+	raw := append([]string(nil), explicit...)
+	if metadataTags := metadata["tags"]; metadataTags != "" {
+		raw = append(raw, strings.Split(metadataTags, ",")...)
+	}
+
+	var tags []string
+	seen := make(map[string]bool, len(raw))
+	for _, tag := range raw {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag != "" && !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags
 }
 
-// Helper Functions
+// matchesTags reports whether a model's tags contain any (or, with matchAll, every) wanted tag
+func matchesTags(tags, wanted []string, matchAll bool) bool {
+	have := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		have[tag] = true
+	}
+
+	for _, tag := range wanted {
+		found := have[strings.ToLower(strings.TrimSpace(tag))]
+		if found && !matchAll {
+			return true
+		}
+		if !found && matchAll {
+			return false
+		}
+	}
+	return matchAll
+}
+
+// normalizeModelProvider resolves provider casing and aliases (e.g. "Gemini" to "google")
+// before classification, keeping the provider as sent in OriginalProvider
+func normalizeModelProvider(model *models.Model) {
+	if model.OriginalProvider == "" {
+		model.OriginalProvider = model.Provider
+	}
+	model.Provider = classifiers.NormalizeProvider(model.Provider)
+}
+
+// groupingProvider returns the provider a model is grouped under for the given grouping mode.
+// Original grouping falls back to the resolved provider when the client sent none.
+func groupingProvider(model *models.Model, providerGrouping string) string {
+	if providerGrouping == ProviderGroupingOriginal && model.OriginalProvider != "" {
+		// Group case and alias variants ("OpenRouter", "openrouter") together
+		return classifiers.NormalizeProvider(model.OriginalProvider)
+	}
+	return model.Provider
+}
 
 // classificationError represents an error during model classification
 type classificationError struct {
@@ -766,25 +1622,41 @@ func convertProtoModelsToInternal(protoModels []*proto.Model) []*models.Model {
 
 	for _, protoModel := range protoModels {
 		model := &models.Model{
-			ID:             protoModel.Id,
-			Name:           protoModel.Name,
-			ContextSize:    protoModel.ContextSize,
-			MaxTokens:      protoModel.MaxTokens,
-			Provider:       protoModel.Provider,
-			OriginalProvider: protoModel.Provider, // Store the original provider
-			DisplayName:    protoModel.DisplayName,
-			Description:    protoModel.Description,
-			CostPerToken:   protoModel.CostPerToken,
-			Capabilities:   protoModel.Capabilities,
-			Family:         protoModel.Family,
-			Type:           protoModel.Type,
-			Series:         protoModel.Series,
-			Variant:        protoModel.Variant,
-			IsDefault:      protoModel.IsDefault,
-			IsMultimodal:   protoModel.IsMultimodal,
-			IsExperimental: protoModel.IsExperimental,
-			Version:        protoModel.Version,
-			Metadata:       protoModel.Metadata,
+			ID:                   protoModel.Id,
+			Name:                 protoModel.Name,
+			ContextSize:          protoModel.ContextSize,
+			MaxTokens:            protoModel.MaxTokens,
+			Provider:             protoModel.Provider,
+			OriginalProvider:     protoModel.Provider, // Store the original provider
+			DisplayName:          protoModel.DisplayName,
+			Description:          protoModel.Description,
+			CostPerToken:         protoModel.CostPerToken,
+			Capabilities:         protoModel.Capabilities,
+			ReasoningTiers:       protoModel.ReasoningTiers,
+			Family:               protoModel.Family,
+			FamilyDisplayName:    protoModel.FamilyDisplayName,
+			Type:                 protoModel.Type,
+			Series:               protoModel.Series,
+			Variant:              protoModel.Variant,
+			IsDefault:            protoModel.IsDefault,
+			IsMultimodal:         protoModel.IsMultimodal,
+			IsExperimental:       protoModel.IsExperimental,
+			Version:              protoModel.Version,
+			Quantization:         protoModel.Quantization,
+			License:              protoModel.License,
+			IsOpenWeight:         protoModel.IsOpenWeight,
+			Tuning:               protoModel.Tuning,
+			InputModalities:      protoModel.InputModalities,
+			OutputModalities:     protoModel.OutputModalities,
+			Tags:                 normalizeTags(protoModel.Tags, protoModel.Metadata),
+			Confidence:           protoModel.Confidence,
+			IsDistilled:          protoModel.IsDistilled,
+			ContextCharsEstimate: protoModel.ContextCharsEstimate,
+			RoutingVariant:       protoModel.RoutingVariant,
+			RawFamily:            protoModel.RawFamily,
+			IsFineTuned:          protoModel.IsFineTuned,
+			BaseModel:            protoModel.BaseModel,
+			Metadata:             protoModel.Metadata,
 		}
 		result = append(result, model)
 	}
@@ -798,24 +1670,41 @@ func convertInternalModelsToProto(internalModels []*models.Model) []*proto.Model
 
 	for _, model := range internalModels {
 		protoModel := &proto.Model{
-			Id:             model.ID,
-			Name:           model.Name,
-			ContextSize:    model.ContextSize,
-			MaxTokens:      model.MaxTokens,
-			Provider:       model.Provider, // This will use the current provider (could be original or classified)
-			DisplayName:    model.DisplayName,
-			Description:    model.Description,
-			CostPerToken:   model.CostPerToken,
-			Capabilities:   model.Capabilities,
-			Family:         model.Family,
-			Type:           model.Type,
-			Series:         model.Series,
-			Variant:        model.Variant,
-			IsDefault:      model.IsDefault,
-			IsMultimodal:   model.IsMultimodal,
-			IsExperimental: model.IsExperimental,
-			Version:        model.Version,
-			Metadata:       model.Metadata,
+			Id:                   model.ID,
+			Name:                 model.Name,
+			ContextSize:          model.ContextSize,
+			MaxTokens:            model.MaxTokens,
+			Provider:             model.Provider, // This will use the current provider (could be original or classified)
+			OriginalProvider:     model.OriginalProvider,
+			DisplayName:          model.DisplayName,
+			Description:          model.Description,
+			CostPerToken:         model.CostPerToken,
+			Capabilities:         model.Capabilities,
+			ReasoningTiers:       model.ReasoningTiers,
+			Family:               model.Family,
+			FamilyDisplayName:    model.FamilyDisplayName,
+			Type:                 model.Type,
+			Series:               model.Series,
+			Variant:              model.Variant,
+			IsDefault:            model.IsDefault,
+			IsMultimodal:         model.IsMultimodal,
+			IsExperimental:       model.IsExperimental,
+			Version:              model.Version,
+			Quantization:         model.Quantization,
+			License:              model.License,
+			IsOpenWeight:         model.IsOpenWeight,
+			Tuning:               model.Tuning,
+			InputModalities:      model.InputModalities,
+			OutputModalities:     model.OutputModalities,
+			Tags:                 model.Tags,
+			Confidence:           model.Confidence,
+			IsDistilled:          model.IsDistilled,
+			ContextCharsEstimate: model.ContextCharsEstimate,
+			RoutingVariant:       model.RoutingVariant,
+			RawFamily:            model.RawFamily,
+			IsFineTuned:          model.IsFineTuned,
+			BaseModel:            model.BaseModel,
+			Metadata:             model.Metadata,
 		}
 		result = append(result, protoModel)
 	}
@@ -823,6 +1712,49 @@ func convertInternalModelsToProto(internalModels []*models.Model) []*proto.Model
 	return result
 }
 
+// convertMetadataToProto converts classifier metadata for a model ID to proto format
+func convertMetadataToProto(modelID string, metadata classifiers.ModelMetadata) *proto.ModelMetadata {
+	return &proto.ModelMetadata{
+		Id:               modelID,
+		Provider:         metadata.Provider,
+		Family:           metadata.Family,
+		Series:           metadata.Series,
+		Type:             metadata.Type,
+		Variant:          metadata.Variant,
+		ContextSize:      int32(metadata.Context),
+		Capabilities:     metadata.Capabilities,
+		IsMultimodal:     metadata.IsMultimodal,
+		IsExperimental:   metadata.IsExperimental,
+		DisplayName:      metadata.DisplayName,
+		Quantization:     metadata.Quantization,
+		License:          metadata.License,
+		IsOpenWeight:     metadata.IsOpenWeight,
+		Tuning:           metadata.Tuning,
+		InputModalities:  metadata.InputModalities,
+		OutputModalities: metadata.OutputModalities,
+		Confidence:       metadata.Confidence,
+		IsDistilled:      metadata.IsDistilled,
+		DistilledFrom:    metadata.DistilledFrom,
+		RoutingVariant:   metadata.RoutingVariant,
+		RawFamily:        metadata.RawFamily,
+	}
+}
+
+// convertSummaryToProto converts a classification summary to proto format
+func convertSummaryToProto(summary *models.ClassificationSummary) *proto.ClassificationSummary {
+	return &proto.ClassificationSummary{
+		TotalModels:       summary.TotalModels,
+		ProviderCounts:    summary.ProviderCounts,
+		TypeCounts:        summary.TypeCounts,
+		CapabilityCounts:  summary.CapabilityCounts,
+		MultimodalCount:   summary.MultimodalCount,
+		ExperimentalCount: summary.ExperimentalCount,
+		MinContextSize:    summary.MinContextSize,
+		MaxContextSize:    summary.MaxContextSize,
+		AvgContextSize:    summary.AvgContextSize,
+	}
+}
+
 // convertToProtoProperties converts classification properties to proto format
 func convertToProtoProperties(properties []*models.ClassificationProperty) []*proto.ClassificationProperty {
 	var result []*proto.ClassificationProperty
@@ -861,16 +1793,36 @@ func convertInternalHierarchicalGroupToProto(internalGroup *models.HierarchicalM
 		GroupName:  internalGroup.GroupName,
 		GroupValue: internalGroup.GroupValue,
 		Models:     protoModels, // Assign converted models
+		ModelCount: int32(len(protoModels)),
 	}
 
 	// Convert children recursively
 	for _, child := range internalGroup.Children {
-		protoGroup.Children = append(protoGroup.Children, convertInternalHierarchicalGroupToProto(child))
+		protoChild := convertInternalHierarchicalGroupToProto(child)
+		protoGroup.Children = append(protoGroup.Children, protoChild)
+		protoGroup.ModelCount += protoChild.ModelCount
 	}
 
 	return protoGroup
 }
 
+// stripGroupModels removes the models from every group in a response, keeping the
+// group structure and model counts
+func stripGroupModels(result *proto.ClassifiedModelResponse) {
+	var strip func(groups []*proto.HierarchicalModelGroup)
+	strip = func(groups []*proto.HierarchicalModelGroup) {
+		for _, group := range groups {
+			group.Models = nil
+			strip(group.Children)
+		}
+	}
+	strip(result.HierarchicalGroups)
+
+	for _, group := range result.ClassifiedGroups {
+		group.Models = nil
+	}
+}
+
 // convertProtoHierarchicalGroupToInternal converts a proto hierarchical group to internal format
 func convertProtoHierarchicalGroupToInternal(protoGroup *proto.HierarchicalModelGroup) *models.HierarchicalModelGroup {
 	internalGroup := &models.HierarchicalModelGroup{
@@ -886,4 +1838,3 @@ func convertProtoHierarchicalGroupToInternal(protoGroup *proto.HierarchicalModel
 
 	return internalGroup
 }
-
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chat-api/model-categorizer/models/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func TestClassifySingleModelValidation(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+
+	tests := []struct {
+		name     string
+		req      *proto.SingleModelRequest
+		wantCode codes.Code
+	}{
+		{"empty ID", &proto.SingleModelRequest{Id: "", Provider: "openai"}, codes.InvalidArgument},
+		{"whitespace ID", &proto.SingleModelRequest{Id: "  \t", Provider: "openai"}, codes.InvalidArgument},
+		{"nil request fields", &proto.SingleModelRequest{}, codes.InvalidArgument},
+		{"valid ID", &proto.SingleModelRequest{Id: "gpt-4o", Provider: "openai"}, codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := h.ClassifySingleModel(context.Background(), tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("ClassifySingleModel(%q) code = %v, want %v", tt.req.Id, status.Code(err), tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestClassifyWithTraceMatchesClassifySingleModel(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+
+	tests := []*proto.SingleModelRequest{
+		{Id: "gpt-4o", Provider: "openai"},
+		{Id: "claude-3-5-sonnet-20241022"},
+		{Id: "meta-llama/llama-3.1-8b-instruct:free", Provider: "openrouter"},
+		// Only the display-name fallback recognizes this one
+		{Id: "Anthropic: Claude 3.5 Sonnet"},
+	}
+
+	for _, req := range tests {
+		t.Run(req.Id, func(t *testing.T) {
+			single, err := h.ClassifySingleModel(context.Background(), req)
+			if err != nil {
+				t.Fatalf("ClassifySingleModel: %v", err)
+			}
+			trace, err := h.ClassifyWithTrace(context.Background(), req)
+			if err != nil {
+				t.Fatalf("ClassifyWithTrace: %v", err)
+			}
+			if !protobuf.Equal(single, trace.Model) {
+				t.Errorf("trace model differs from ClassifySingleModel:\n got  %v\n want %v", trace.Model, single)
+			}
+			if len(trace.Steps) == 0 {
+				t.Error("trace has no steps")
+			}
+		})
+	}
+}
+
+func TestClassifyWithTraceValidation(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+
+	for _, id := range []string{"", " "} {
+		_, err := h.ClassifyWithTrace(context.Background(), &proto.SingleModelRequest{Id: id, Provider: "openai"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("ClassifyWithTrace(%q) code = %v, want %v", id, status.Code(err), codes.InvalidArgument)
+		}
+	}
+}
+
+func TestClassifyModelsReasoningTiers(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	req := &proto.LoadedModelList{Models: []*proto.Model{{Id: "o3-mini"}, {Id: "gpt-4o"}}}
+
+	resp, err := h.ClassifyModels(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ClassifyModels: %v", err)
+	}
+
+	byID := protoHierarchyModels(resp.HierarchicalGroups, nil)
+	if got, want := byID["o3-mini"].GetReasoningTiers(), []string{"low", "medium", "high"}; !equalStrings(got, want) {
+		t.Errorf("o3-mini reasoning tiers = %v, want %v", got, want)
+	}
+	if got := byID["gpt-4o"].GetReasoningTiers(); len(got) != 0 {
+		t.Errorf("gpt-4o reasoning tiers = %v, want none", got)
+	}
+}
+
+func TestCompareModelsGPT4oVsClaudeSonnet(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	req := &proto.CompareModelsRequest{
+		A: &proto.SingleModelRequest{Id: "gpt-4o"},
+		B: &proto.SingleModelRequest{Id: "claude-3-5-sonnet-20241022"},
+	}
+
+	resp, err := h.CompareModels(context.Background(), req)
+	if err != nil {
+		t.Fatalf("CompareModels: %v", err)
+	}
+
+	fields := make(map[string]*proto.FieldComparison)
+	for _, field := range resp.Fields {
+		fields[field.Field] = field
+	}
+	if contextSize := fields["context_size"]; contextSize.GetA() != "128000" || contextSize.GetB() != "200000" || contextSize.GetResult() != "b_greater" {
+		t.Errorf("context_size = %v, want 128000 vs 200000, b_greater", contextSize)
+	}
+	if provider := fields["provider"]; provider.GetResult() != "different" {
+		t.Errorf("provider = %v, want different", provider)
+	}
+
+	// Both accept images and tools, so no capability is exclusive to either
+	if want := []string{"chat", "function-calling", "vision"}; !equalStrings(resp.CommonCapabilities, want) {
+		t.Errorf("CommonCapabilities = %v, want %v", resp.CommonCapabilities, want)
+	}
+	if len(resp.OnlyACapabilities) != 0 || len(resp.OnlyBCapabilities) != 0 {
+		t.Errorf("exclusive capabilities = %v / %v, want none", resp.OnlyACapabilities, resp.OnlyBCapabilities)
+	}
+	if resp.A.GetId() != "gpt-4o" || resp.B.GetId() != "claude-3-5-sonnet-20241022" {
+		t.Errorf("compared %q and %q, want gpt-4o and claude-3-5-sonnet-20241022", resp.A.GetId(), resp.B.GetId())
+	}
+}
+
+func TestCompareModelsValidation(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	req := &proto.CompareModelsRequest{A: &proto.SingleModelRequest{Id: "gpt-4o"}}
+
+	if _, err := h.CompareModels(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// DefaultCompressionThreshold is the smallest response, in bytes, worth gzip-compressing
+const DefaultCompressionThreshold = 1024
+
+// CompressionInterceptor returns an interceptor that gzip-compresses responses of at
+// least minSize bytes for clients advertising gzip in grpc-accept-encoding. Smaller
+// responses are sent uncompressed even when the request itself was compressed, since
+// the gzip framing would cost more than it saves.
+func CompressionInterceptor(minSize int) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+
+		msg, ok := resp.(protobuf.Message)
+		if !ok {
+			return resp, nil
+		}
+
+		compressor := encoding.Identity
+		if protobuf.Size(msg) >= minSize && clientAcceptsGzip(ctx) {
+			compressor = gzip.Name
+		}
+		if err := grpc.SetSendCompressor(ctx, compressor); err != nil {
+			// Compression is an optimization; never fail the RPC over it
+			slog.Debug("Failed to set response compressor", "method", info.FullMethod, "error", err)
+		}
+		return resp, nil
+	}
+}
+
+// clientAcceptsGzip reports whether the client advertised gzip support
+func clientAcceptsGzip(ctx context.Context) bool {
+	names, err := grpc.ClientSupportedCompressors(ctx)
+	if err != nil {
+		return false
+	}
+	for _, name := range names {
+		if name == gzip.Name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chat-api/model-categorizer/models/proto"
+)
+
+// Defaults for the in-flight classification RPC limit
+const (
+	DefaultConcurrentRequestLimit = 100
+	DefaultConcurrencyWait        = 2 * time.Second
+)
+
+// ConcurrencyLimiter caps the number of in-flight classification RPCs with a semaphore
+type ConcurrencyLimiter struct {
+	slots chan struct{}
+	wait  time.Duration
+}
+
+// NewConcurrencyLimiter creates a limiter allowing up to limit concurrent RPCs.
+// Excess calls wait up to wait for a free slot before being rejected.
+func NewConcurrencyLimiter(limit int, wait time.Duration) *ConcurrencyLimiter {
+	return &ConcurrencyLimiter{
+		slots: make(chan struct{}, limit),
+		wait:  wait,
+	}
+}
+
+// UnaryServerInterceptor returns an interceptor enforcing the limit on the
+// classification service; other services such as health checks are not limited
+func (l *ConcurrencyLimiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	servicePrefix := "/" + proto.ModelClassificationService_ServiceDesc.ServiceName + "/"
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, servicePrefix) {
+			return handler(ctx, req)
+		}
+
+		if err := l.acquire(ctx); err != nil {
+			slog.Warn("Rejected request over concurrency limit",
+				"method", info.FullMethod,
+				"limit", cap(l.slots))
+			return nil, err
+		}
+		defer l.release()
+
+		return handler(ctx, req)
+	}
+}
+
+// acquire takes a slot, waiting up to the configured timeout
+func (l *ConcurrencyLimiter) acquire(ctx context.Context) error {
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	if l.wait <= 0 {
+		return status.Errorf(codes.ResourceExhausted, "too many concurrent requests (limit %d)", cap(l.slots))
+	}
+
+	timer := time.NewTimer(l.wait)
+	defer timer.Stop()
+
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-timer.C:
+		return status.Errorf(codes.ResourceExhausted, "too many concurrent requests (limit %d)", cap(l.slots))
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// release frees a slot
+func (l *ConcurrencyLimiter) release() {
+	<-l.slots
+}
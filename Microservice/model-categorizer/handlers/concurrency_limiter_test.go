@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const classifyMethod = "/modelservice.ModelClassificationService/ClassifyModels"
+
+func TestConcurrencyLimiterRejectsExcessCalls(t *testing.T) {
+	const limit, calls = 2, 5
+	interceptor := NewConcurrencyLimiter(limit, 50*time.Millisecond).UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: classifyMethod}
+
+	entered := make(chan struct{}, calls)
+	release := make(chan struct{})
+	blocking := func(ctx context.Context, req interface{}) (interface{}, error) {
+		entered <- struct{}{}
+		<-release
+		return "ok", nil
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, calls)
+	for i := 0; i < calls; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := interceptor(context.Background(), nil, info, blocking)
+			errs <- err
+		}()
+	}
+
+	// The excess calls give up after the wait while the first calls still hold their slots
+	for i := 0; i < calls-limit; i++ {
+		select {
+		case err := <-errs:
+			if status.Code(err) != codes.ResourceExhausted {
+				t.Errorf("excess call: code = %v, want %v", status.Code(err), codes.ResourceExhausted)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("excess calls were not rejected")
+		}
+	}
+	for i := 0; i < limit; i++ {
+		<-entered
+	}
+	if len(entered) != 0 {
+		t.Errorf("%d calls ran concurrently, want %d", limit+len(entered), limit)
+	}
+
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("call within the limit failed: %v", err)
+		}
+	}
+}
+
+func TestConcurrencyLimiterQueuesUntilSlotFrees(t *testing.T) {
+	interceptor := NewConcurrencyLimiter(1, 5*time.Second).UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: classifyMethod}
+
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			close(entered)
+			<-release
+			return nil, nil
+		})
+		done <- err
+	}()
+	<-entered
+
+	// The second call waits for the slot instead of being rejected
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+	}()
+	if _, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}); err != nil {
+		t.Errorf("queued call: %v", err)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("first call: %v", err)
+	}
+}
+
+func TestConcurrencyLimiterSkipsOtherServices(t *testing.T) {
+	interceptor := NewConcurrencyLimiter(1, 0).UnaryServerInterceptor()
+	release := make(chan struct{})
+	entered := make(chan struct{})
+	go interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: classifyMethod},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			close(entered)
+			<-release
+			return nil, nil
+		})
+	<-entered
+	defer close(release)
+
+	health := &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}
+	if _, err := interceptor(context.Background(), nil, health, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}); err != nil {
+		t.Errorf("health check was limited: %v", err)
+	}
+	if _, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: classifyMethod},
+		func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("classification call over the limit: code = %v, want %v", status.Code(err), codes.ResourceExhausted)
+	}
+}
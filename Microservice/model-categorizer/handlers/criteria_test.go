@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chat-api/model-categorizer/models"
+	"github.com/chat-api/model-categorizer/models/proto"
+)
+
+// criteriaContext carries models the way ClassifyModelsWithCriteria expects them
+func criteriaContext(modelsList ...*models.Model) context.Context {
+	return context.WithValue(context.Background(), "models", &models.LoadedModelList{Models: modelsList})
+}
+
+func TestClassifyModelsWithCriteriaContextBounds(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	newModels := func() []*models.Model {
+		return []*models.Model{
+			{ID: "custom-at-min", Provider: "acme", ContextSize: 8192},
+			{ID: "custom-below-min", Provider: "acme", ContextSize: 8191},
+			{ID: "custom-at-max", Provider: "acme", ContextSize: 128000},
+			{ID: "custom-above-max", Provider: "acme", ContextSize: 128001},
+			// No supplied size: the classifier resolves 128K and 200K windows
+			{ID: "gpt-4o", Provider: "openai"},
+			{ID: "claude-3-5-sonnet-20241022", Provider: "anthropic"},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		min, max int32
+		want     []string
+	}{
+		{"range", 8192, 128000, []string{"custom-at-max", "custom-at-min", "gpt-4o"}},
+		{"min only", 128000, 0, []string{"claude-3-5-sonnet-20241022", "custom-above-max", "custom-at-max", "gpt-4o"}},
+		{"max only", 0, 8192, []string{"custom-at-min", "custom-below-min"}},
+		{"min equals max", 128000, 128000, []string{"custom-at-max", "gpt-4o"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &proto.ClassificationCriteria{
+				Properties:     []string{PropertyMultimodal},
+				MinContextSize: tt.min,
+				MaxContextSize: tt.max,
+				KeepDuplicates: true,
+			}
+			resp, err := h.ClassifyModelsWithCriteria(criteriaContext(newModels()...), req)
+			if err != nil {
+				t.Fatalf("ClassifyModelsWithCriteria: %v", err)
+			}
+
+			var got []string
+			for _, group := range resp.ClassifiedGroups {
+				for _, model := range group.Models {
+					got = append(got, model.Id)
+				}
+			}
+			sort.Strings(got)
+			if !equalStrings(got, tt.want) {
+				t.Errorf("models = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifyModelsWithCriteriaRejectsInvertedRange(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	req := &proto.ClassificationCriteria{MinContextSize: 200000, MaxContextSize: 8192}
+
+	_, err := h.ClassifyModelsWithCriteria(criteriaContext(&models.Model{ID: "gpt-4o"}), req)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+// equalStrings reports whether two string slices hold the same values in the same order
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestClassifyModelsWithCriteriaNilCriteria(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	ctx := criteriaContext(
+		&models.Model{ID: "gpt-4o", Provider: "openai"},
+		&models.Model{ID: "claude-3-5-sonnet-20241022", Provider: "anthropic"},
+	)
+
+	resp, err := h.ClassifyModelsWithCriteria(ctx, nil)
+	if err != nil {
+		t.Fatalf("ClassifyModelsWithCriteria(nil): %v", err)
+	}
+	if resp.ErrorMessage != "" {
+		t.Fatalf("ErrorMessage = %q, want none", resp.ErrorMessage)
+	}
+	if len(resp.ClassifiedGroups) != 0 {
+		t.Errorf("got %d flat groups, want a hierarchical response", len(resp.ClassifiedGroups))
+	}
+
+	var providers []string
+	for _, group := range resp.HierarchicalGroups {
+		if group.GroupName != PropertyProvider {
+			t.Errorf("root group name = %q, want %q", group.GroupName, PropertyProvider)
+		}
+		providers = append(providers, group.GroupValue)
+	}
+	sort.Strings(providers)
+	if want := []string{"anthropic", "openai"}; !equalStrings(providers, want) {
+		t.Errorf("root providers = %v, want %v", providers, want)
+	}
+	if resp.Summary.GetTotalModels() != 2 {
+		t.Errorf("summary total = %d, want 2", resp.Summary.GetTotalModels())
+	}
+}
+
+func TestFilterModelsByCriteriaNilCriteria(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	modelsList := []*models.Model{{ID: "gpt-4o"}, {ID: "gpt-4o-mini"}}
+
+	if got := h.filterModelsByCriteria(modelsList, nil); len(got) != len(modelsList) {
+		t.Errorf("filterModelsByCriteria(nil) kept %d models, want %d", len(got), len(modelsList))
+	}
+}
+
+func TestClassifyModelsWithCriteriaGroupsByTuning(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	ctx := criteriaContext(
+		&models.Model{ID: "llama-3.1-70b", Provider: "meta"},
+		&models.Model{ID: "llama-3.1-70b-instruct", Provider: "meta"},
+	)
+	req := &proto.ClassificationCriteria{Properties: []string{PropertyTuning}}
+
+	resp, err := h.ClassifyModelsWithCriteria(ctx, req)
+	if err != nil {
+		t.Fatalf("ClassifyModelsWithCriteria: %v", err)
+	}
+
+	got := make(map[string][]string)
+	for _, group := range resp.ClassifiedGroups {
+		if group.PropertyName != PropertyTuning {
+			t.Errorf("group property = %q, want %q", group.PropertyName, PropertyTuning)
+		}
+		for _, model := range group.Models {
+			got[group.PropertyValue] = append(got[group.PropertyValue], model.Id)
+		}
+	}
+	if !equalStrings(got["base"], []string{"llama-3.1-70b"}) {
+		t.Errorf("base group = %v, want [llama-3.1-70b]", got["base"])
+	}
+	if !equalStrings(got["instruct"], []string{"llama-3.1-70b-instruct"}) {
+		t.Errorf("instruct group = %v, want [llama-3.1-70b-instruct]", got["instruct"])
+	}
+}
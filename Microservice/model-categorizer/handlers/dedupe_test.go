@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chat-api/model-categorizer/models"
+	"github.com/chat-api/model-categorizer/models/proto"
+)
+
+// countProtoModels counts how often each model ID appears in a hierarchical response
+func countProtoModels(groups []*proto.HierarchicalModelGroup, counts map[string]int) map[string]int {
+	if counts == nil {
+		counts = make(map[string]int)
+	}
+	for _, group := range groups {
+		for _, model := range group.Models {
+			counts[model.Id]++
+		}
+		countProtoModels(group.Children, counts)
+	}
+	return counts
+}
+
+func TestDedupeModels(t *testing.T) {
+	modelsList := []*models.Model{
+		{ID: "gpt-4o", Provider: "openai", Name: "first", Metadata: map[string]string{"tier": "pro"}},
+		{ID: "claude-3-5-sonnet-20241022", Provider: "anthropic"},
+		{ID: "GPT-4o", Provider: "OpenAI", Name: "second", Metadata: map[string]string{"tier": "free", "region": "eu"}},
+		{ID: "gpt-4o", Provider: "azure"},
+	}
+
+	got := dedupeModels("test", modelsList)
+	if len(got) != 3 {
+		t.Fatalf("kept %d models, want 3 (same ID from another provider is not a duplicate)", len(got))
+	}
+
+	first := got[0]
+	if first.Name != "first" {
+		t.Errorf("kept %q, want the first occurrence", first.Name)
+	}
+	// Metadata from duplicates fills gaps without overwriting the first occurrence
+	if first.Metadata["tier"] != "pro" || first.Metadata["region"] != "eu" {
+		t.Errorf("merged metadata = %v, want tier=pro and region=eu", first.Metadata)
+	}
+}
+
+func TestClassifyModelsDuplicateIDs(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	request := func(keepDuplicates bool) *proto.LoadedModelList {
+		return &proto.LoadedModelList{
+			Models: []*proto.Model{
+				{Id: "gpt-4o", Provider: "openai"},
+				{Id: "gpt-4o", Provider: "openai"},
+				{Id: "claude-3-5-sonnet-20241022", Provider: "anthropic"},
+			},
+			KeepDuplicates: keepDuplicates,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		keepDuplicates bool
+		wantCount      int
+	}{
+		{"deduplicated by default", false, 1},
+		{"kept on request", true, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := h.ClassifyModels(context.Background(), request(tt.keepDuplicates))
+			if err != nil {
+				t.Fatalf("ClassifyModels: %v", err)
+			}
+			counts := countProtoModels(resp.HierarchicalGroups, nil)
+			if counts["gpt-4o"] != tt.wantCount {
+				t.Errorf("gpt-4o appears %d times, want %d", counts["gpt-4o"], tt.wantCount)
+			}
+			if counts["claude-3-5-sonnet-20241022"] != 1 {
+				t.Errorf("claude-3-5-sonnet appears %d times, want 1", counts["claude-3-5-sonnet-20241022"])
+			}
+			if int(resp.Summary.TotalModels) != tt.wantCount+1 {
+				t.Errorf("summary total = %d, want %d", resp.Summary.TotalModels, tt.wantCount+1)
+			}
+		})
+	}
+}
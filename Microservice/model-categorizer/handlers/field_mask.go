@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/chat-api/model-categorizer/models/proto"
+)
+
+// modelFieldSet resolves requested Model field names (proto names such as "id" or
+// "context_size") into a set. An empty request means every field is kept.
+func modelFieldSet(fields []string) (map[protoreflect.Name]bool, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	descriptor := (&proto.Model{}).ProtoReflect().Descriptor().Fields()
+	set := make(map[protoreflect.Name]bool, len(fields))
+	for _, field := range fields {
+		name := protoreflect.Name(strings.TrimSpace(field))
+		if descriptor.ByName(name) == nil {
+			return nil, fmt.Errorf("unknown model field %q", field)
+		}
+		set[name] = true
+	}
+	return set, nil
+}
+
+// maskModel clears every populated field of a model that is not in the set
+func maskModel(model *proto.Model, keep map[protoreflect.Name]bool) {
+	message := model.ProtoReflect()
+	message.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if !keep[fd.Name()] {
+			message.Clear(fd)
+		}
+		return true
+	})
+}
+
+// maskedResponse returns the response with its models restricted to the kept fields.
+// Shared responses (cached or completed) are cloned rather than masked in place.
+func maskedResponse(result *proto.ClassifiedModelResponse, keep map[protoreflect.Name]bool) *proto.ClassifiedModelResponse {
+	if keep == nil {
+		return result
+	}
+	masked := protobuf.Clone(result).(*proto.ClassifiedModelResponse)
+	maskResponseModels(masked, keep)
+	return masked
+}
+
+// maskResponseModels restricts every model in a response to the kept fields
+func maskResponseModels(result *proto.ClassifiedModelResponse, keep map[protoreflect.Name]bool) {
+	if keep == nil {
+		return
+	}
+
+	var maskGroups func(groups []*proto.HierarchicalModelGroup)
+	maskGroups = func(groups []*proto.HierarchicalModelGroup) {
+		for _, group := range groups {
+			for _, model := range group.Models {
+				maskModel(model, keep)
+			}
+			maskGroups(group.Children)
+		}
+	}
+	maskGroups(result.HierarchicalGroups)
+
+	for _, group := range result.ClassifiedGroups {
+		for _, model := range group.Models {
+			maskModel(model, keep)
+		}
+	}
+}
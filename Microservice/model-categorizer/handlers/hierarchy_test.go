@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chat-api/model-categorizer/models"
+	"github.com/chat-api/model-categorizer/models/proto"
+)
+
+func TestBuildModelHierarchyGroupsSnapshotsWithRequestSnapshot(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	ctx := context.Background()
+	modelsList := []*models.Model{
+		{ID: "gpt-4o-2024-08-06", Provider: "openai"},
+		{ID: "gpt-4o", Provider: "openai"},
+		{ID: "gpt-4o-2024-05-13", Provider: "openai"},
+	}
+
+	// A reload between enhancement and grouping must not change the classifier the
+	// request groups with
+	live := h.live.Load()
+	enhanced := h.enhanceModels(ctx, modelsList, nil, live)
+	h.ReloadClassifier()
+	if h.live.Load() == live {
+		t.Fatal("ReloadClassifier did not swap the classifier state")
+	}
+	rootGroups := h.buildModelHierarchy(ctx, enhanced, DefaultHierarchyDimensions, ProviderGroupingOriginal, false, live)
+
+	alias := findGroup(rootGroups, "alias", "gpt-4o")
+	if alias == nil {
+		t.Fatalf("no alias group for gpt-4o in %+v", rootGroups)
+	}
+	if len(alias.Models) != len(modelsList) {
+		t.Fatalf("alias group holds %d models, want %d", len(alias.Models), len(modelsList))
+	}
+	for _, model := range alias.Models {
+		if wantDefault := model.ID == "gpt-4o"; model.IsDefault != wantDefault {
+			t.Errorf("%s IsDefault = %v, want %v", model.ID, model.IsDefault, wantDefault)
+		}
+	}
+}
+
+// findGroup returns the first group in the tree with the given name and value
+func findGroup(groups []*models.HierarchicalModelGroup, name, value string) *models.HierarchicalModelGroup {
+	for _, group := range groups {
+		if group.GroupName == name && group.GroupValue == value {
+			return group
+		}
+		if found := findGroup(group.Children, name, value); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// groupModelIDs returns the IDs of all models in a group and its children
+func groupModelIDs(group *models.HierarchicalModelGroup) map[string]bool {
+	ids := make(map[string]bool)
+	for _, model := range group.Models {
+		ids[model.ID] = true
+	}
+	for _, child := range group.Children {
+		for id := range groupModelIDs(child) {
+			ids[id] = true
+		}
+	}
+	return ids
+}
+
+func TestBuildModelHierarchyFansOutCapabilities(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	ctx := context.Background()
+	modelsList := []*models.Model{
+		{ID: "gpt-4o", Provider: "openai"},
+		{ID: "text-embedding-3-small", Provider: "openai"},
+	}
+
+	live := h.live.Load()
+	enhanced := h.enhanceModels(ctx, modelsList, nil, live)
+	dimensions := []string{PropertyProvider, PropertyCapability}
+	rootGroups := h.buildModelHierarchy(ctx, enhanced, dimensions, ProviderGroupingOriginal, false, live)
+
+	openai := findGroup(rootGroups, PropertyProvider, "openai")
+	if openai == nil {
+		t.Fatalf("no openai group in %+v", rootGroups)
+	}
+
+	// The vision and function-calling model sits under both capability subtrees
+	for _, capability := range []string{"vision", "function-calling"} {
+		group := findGroup(openai.Children, PropertyCapability, capability)
+		if group == nil {
+			t.Fatalf("no %q capability group under openai", capability)
+		}
+		ids := groupModelIDs(group)
+		if !ids["gpt-4o"] {
+			t.Errorf("gpt-4o missing from the %q subtree", capability)
+		}
+		if ids["text-embedding-3-small"] {
+			t.Errorf("text-embedding-3-small listed under %q", capability)
+		}
+	}
+
+	embedding := findGroup(openai.Children, PropertyCapability, "embedding")
+	if embedding == nil || !groupModelIDs(embedding)["text-embedding-3-small"] {
+		t.Errorf("text-embedding-3-small missing from the embedding subtree")
+	}
+}
+
+func TestHierarchyDimensionsDepthCap(t *testing.T) {
+	h := NewModelClassificationHandler(false, WithMaxHierarchyDepth(2))
+	req := &proto.ClassificationCriteria{
+		HierarchyDimensions: []string{PropertyProvider, PropertyCapability, PropertyCapability, PropertyType},
+	}
+
+	dimensions, warning := h.hierarchyDimensions(req)
+	if want := []string{PropertyProvider, PropertyCapability}; !equalStrings(dimensions, want) {
+		t.Errorf("dimensions = %v, want %v", dimensions, want)
+	}
+	if warning == "" {
+		t.Error("capping the depth returned no warning")
+	}
+}
+
+// hierarchyDepth returns the number of dimension levels below the given groups.
+// Alias groups for dated snapshots are not a dimension and are not counted.
+func hierarchyDepth(groups []*proto.HierarchicalModelGroup) int {
+	depth := 0
+	for _, group := range groups {
+		if group.GroupName == "alias" {
+			continue
+		}
+		if d := 1 + hierarchyDepth(group.Children); d > depth {
+			depth = d
+		}
+	}
+	return depth
+}
+
+func TestClassifyModelsWithCriteriaCapsHierarchyDepth(t *testing.T) {
+	dimensions := []string{PropertyProvider, PropertyType, PropertyVariant, PropertyCapability, PropertyLicense, PropertyMultimodal}
+	ctx := func() context.Context {
+		return criteriaContext(
+			&models.Model{ID: "gpt-4o", Provider: "openai"},
+			&models.Model{ID: "claude-3-5-sonnet-20241022", Provider: "anthropic"},
+			&models.Model{ID: "llama-3.1-70b-instruct", Provider: "meta"},
+		)
+	}
+
+	tests := []struct {
+		name      string
+		handler   *ModelClassificationHandler
+		reqDepth  int32
+		wantDepth int
+	}{
+		{"default cap", NewModelClassificationHandler(false), 0, DefaultMaxHierarchyDepth},
+		{"request lowers the cap", NewModelClassificationHandler(false), 2, 2},
+		{"request cannot raise the cap", NewModelClassificationHandler(false), 6, DefaultMaxHierarchyDepth},
+		{"cap disabled", NewModelClassificationHandler(false, WithMaxHierarchyDepth(0)), 0, len(dimensions)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &proto.ClassificationCriteria{
+				Hierarchical:        true,
+				HierarchyDimensions: dimensions,
+				MaxHierarchyDepth:   tt.reqDepth,
+			}
+			resp, err := tt.handler.ClassifyModelsWithCriteria(ctx(), req)
+			if err != nil {
+				t.Fatalf("ClassifyModelsWithCriteria: %v", err)
+			}
+
+			if got := hierarchyDepth(resp.HierarchicalGroups); got != tt.wantDepth {
+				t.Errorf("depth = %d, want %d", got, tt.wantDepth)
+			}
+			if truncated := tt.wantDepth < len(dimensions); truncated != (len(resp.Warnings) > 0) {
+				t.Errorf("warnings = %v, want a warning only when truncated (%v)", resp.Warnings, truncated)
+			}
+			if got := len(protoHierarchyModels(resp.HierarchicalGroups, nil)); got != 3 {
+				t.Errorf("tree holds %d models, want 3", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/chat-api/model-categorizer/models/proto"
+)
+
+// protoHierarchyModels indexes the models of a hierarchical response by ID
+func protoHierarchyModels(groups []*proto.HierarchicalModelGroup, byID map[string]*proto.Model) map[string]*proto.Model {
+	if byID == nil {
+		byID = make(map[string]*proto.Model)
+	}
+	for _, group := range groups {
+		for _, model := range group.Models {
+			byID[model.Id] = model
+		}
+		protoHierarchyModels(group.Children, byID)
+	}
+	return byID
+}
+
+func TestGetModelsMetadataMatchesClassifyModels(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	ids := []string{
+		"gpt-4o", "gpt-4o-mini", "o3-mini", "claude-3-5-sonnet-20241022", "gemini-2.0-flash",
+		"llama-3.1-70b-instruct", "mistral-large-latest", "text-embedding-3-small", "whisper-1",
+		"meta-llama/llama-3.1-8b-instruct:free", "unknown-model",
+	}
+	var protoModels []*proto.Model
+	for _, id := range ids {
+		protoModels = append(protoModels, &proto.Model{Id: id})
+	}
+
+	metadataResp, err := h.GetModelsMetadata(context.Background(), &proto.LoadedModelList{Models: protoModels})
+	if err != nil {
+		t.Fatalf("GetModelsMetadata: %v", err)
+	}
+	classified, err := h.ClassifyModels(context.Background(), &proto.LoadedModelList{Models: protoModels})
+	if err != nil {
+		t.Fatalf("ClassifyModels: %v", err)
+	}
+	pipeline := protoHierarchyModels(classified.HierarchicalGroups, nil)
+
+	if len(metadataResp.Models) != len(ids) {
+		t.Fatalf("GetModelsMetadata returned %d models, want %d", len(metadataResp.Models), len(ids))
+	}
+	for i, metadata := range metadataResp.Models {
+		if metadata.Id != ids[i] {
+			t.Errorf("model %d: id = %q, want %q in request order", i, metadata.Id, ids[i])
+			continue
+		}
+		model, ok := pipeline[metadata.Id]
+		if !ok {
+			t.Errorf("%s: missing from the ClassifyModels hierarchy", metadata.Id)
+			continue
+		}
+
+		fields := []struct {
+			name          string
+			flat, fullRun interface{}
+		}{
+			{"provider", metadata.Provider, model.Provider},
+			{"family", metadata.Family, model.Family},
+			{"series", metadata.Series, model.Series},
+			{"type", metadata.Type, model.Type},
+			{"variant", metadata.Variant, model.Variant},
+			{"context size", metadata.ContextSize, model.ContextSize},
+			{"capabilities", metadata.Capabilities, model.Capabilities},
+			{"multimodal", metadata.IsMultimodal, model.IsMultimodal},
+			{"experimental", metadata.IsExperimental, model.IsExperimental},
+			{"license", metadata.License, model.License},
+			{"tuning", metadata.Tuning, model.Tuning},
+			{"routing variant", metadata.RoutingVariant, model.RoutingVariant},
+		}
+		for _, field := range fields {
+			if !reflect.DeepEqual(field.flat, field.fullRun) {
+				t.Errorf("%s: %s = %v from GetModelsMetadata, %v from ClassifyModels",
+					metadata.Id, field.name, field.flat, field.fullRun)
+			}
+		}
+	}
+}
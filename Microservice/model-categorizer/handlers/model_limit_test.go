@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/chat-api/model-categorizer/models"
+	"github.com/chat-api/model-categorizer/models/proto"
+)
+
+func TestMaxModelsPerRequest(t *testing.T) {
+	const limit = 3
+	h := NewModelClassificationHandler(false, WithMaxModelsPerRequest(limit))
+
+	protoModels := func(n int) []*proto.Model {
+		var list []*proto.Model
+		for i := 0; i < n; i++ {
+			list = append(list, &proto.Model{Id: fmt.Sprintf("gpt-4o-%d", i), Provider: "openai"})
+		}
+		return list
+	}
+	internalModels := func(n int) []*models.Model {
+		var list []*models.Model
+		for i := 0; i < n; i++ {
+			list = append(list, &models.Model{ID: fmt.Sprintf("gpt-4o-%d", i), Provider: "openai"})
+		}
+		return list
+	}
+
+	calls := map[string]func(n int) error{
+		"ClassifyModels": func(n int) error {
+			_, err := h.ClassifyModels(context.Background(), &proto.LoadedModelList{Models: protoModels(n)})
+			return err
+		},
+		"ClassifyModelsWithCriteria": func(n int) error {
+			_, err := h.ClassifyModelsWithCriteria(criteriaContext(internalModels(n)...), nil)
+			return err
+		},
+		"GetModelsMetadata": func(n int) error {
+			_, err := h.GetModelsMetadata(context.Background(), &proto.LoadedModelList{Models: protoModels(n)})
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			if err := call(limit); err != nil {
+				t.Errorf("%d models (at the limit): %v", limit, err)
+			}
+			if err := call(limit + 1); status.Code(err) != codes.ResourceExhausted {
+				t.Errorf("%d models (over the limit): code = %v, want %v", limit+1, status.Code(err), codes.ResourceExhausted)
+			}
+		})
+	}
+}
+
+func TestMaxModelsPerRequestDisabled(t *testing.T) {
+	h := NewModelClassificationHandler(false, WithMaxModelsPerRequest(0))
+	if err := h.checkModelLimit("test", DefaultMaxModelsPerRequest+1); err != nil {
+		t.Errorf("disabled limit rejected request: %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import "github.com/chat-api/model-categorizer/classifiers"
+
+// DefaultMaxModelsPerRequest is the default cap on models accepted in a single request
+const DefaultMaxModelsPerRequest = 10000
+
+// Option configures a ModelClassificationHandler
+type Option func(*ModelClassificationHandler)
+
+// WithMaxModelsPerRequest caps the number of models accepted in a single request.
+// A value of zero or less disables the limit.
+func WithMaxModelsPerRequest(max int) Option {
+	return func(h *ModelClassificationHandler) {
+		h.maxModelsPerRequest = max
+	}
+}
+
+// WithMaxHierarchyDepth caps the number of levels in a classification hierarchy;
+// requested dimensions beyond it are not split. A value of zero or less disables the cap.
+func WithMaxHierarchyDepth(depth int) Option {
+	return func(h *ModelClassificationHandler) {
+		h.maxHierarchyDepth = depth
+	}
+}
+
+// WithExperimentalOverrides forces the listed model IDs to be reported as stable or
+// experimental, overriding the classifier's name-based heuristic
+func WithExperimentalOverrides(stable, experimental []string) Option {
+	return func(h *ModelClassificationHandler) {
+		h.classifierOpts = append(h.classifierOpts, classifiers.WithExperimentalOverrides(stable, experimental))
+	}
+}
+
+// WithExperimentalKeywords replaces the name markers that flag a model as experimental
+// (see classifiers.DefaultExperimentalKeywords). An empty list keeps the defaults.
+func WithExperimentalKeywords(keywords []string) Option {
+	return func(h *ModelClassificationHandler) {
+		h.classifierOpts = append(h.classifierOpts, classifiers.WithExperimentalKeywords(keywords))
+	}
+}
+
+// WithFamilyDisplayNames sets branded or localized labels for family values.
+// Families without an entry are displayed as-is.
+func WithFamilyDisplayNames(names map[string]string) Option {
+	return func(h *ModelClassificationHandler) {
+		h.familyDisplayNames = names
+	}
+}
+
+// WithPatterns adds name patterns from a pattern file to the classifier's built-in tables
+func WithPatterns(patterns classifiers.PatternFile) Option {
+	return func(h *ModelClassificationHandler) {
+		h.classifierOpts = append(h.classifierOpts, classifiers.WithPatterns(patterns))
+	}
+}
+
+// WithAdminToken enables the ReloadConfiguration RPC for callers presenting the token
+// as "authorization: Bearer <token>" metadata. An empty token leaves the RPC disabled.
+func WithAdminToken(token string) Option {
+	return func(h *ModelClassificationHandler) {
+		h.adminToken = token
+	}
+}
+
+// WithConfigLoader sets the function ReloadConfig calls to read fresh classifier
+// options, e.g. from the environment and the files it names
+func WithConfigLoader(load func() ([]Option, error)) Option {
+	return func(h *ModelClassificationHandler) {
+		h.loadConfig = load
+	}
+}
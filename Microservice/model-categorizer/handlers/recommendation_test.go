@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chat-api/model-categorizer/models/proto"
+)
+
+func TestRecommendModelUsesRegistryPrices(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	catalog := []*proto.Model{{Id: "gpt-4-turbo"}, {Id: "gpt-4o"}, {Id: "gpt-4o-mini"}}
+
+	tests := []struct {
+		name     string
+		maxPrice float64
+		wantID   string
+		wantErr  bool
+	}{
+		// Registry input prices per million: gpt-4o-mini 0.15, gpt-4o 2.5, gpt-4-turbo 10
+		{"cheapest without a budget", 0, "gpt-4o-mini", false},
+		{"budget below every price", 0.1, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := h.RecommendModel(context.Background(), &proto.RecommendationRequest{
+				Models:                  catalog,
+				RequiredCapabilities:    []string{"vision"},
+				MaxInputPricePerMillion: tt.maxPrice,
+			})
+			if err != nil {
+				t.Fatalf("RecommendModel: %v", err)
+			}
+			if tt.wantErr {
+				if resp.ErrorMessage == "" || resp.Model != nil {
+					t.Errorf("got model %v, want an error message", resp.Model)
+				}
+				return
+			}
+			if resp.Model == nil || resp.Model.Id != tt.wantID {
+				t.Errorf("recommended %v (error %q), want %q", resp.Model, resp.ErrorMessage, tt.wantID)
+			}
+		})
+	}
+}
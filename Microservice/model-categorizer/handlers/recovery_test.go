@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecoveryInterceptor(t *testing.T) {
+	interceptor := RecoveryInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/modelservice.ModelClassificationService/ClassifySingleModel"}
+
+	panicking := func(ctx context.Context, req interface{}) (interface{}, error) {
+		panic("index out of range")
+	}
+	resp, err := interceptor(context.Background(), nil, info, panicking)
+	if resp != nil {
+		t.Errorf("resp = %v, want nil", resp)
+	}
+	if status.Code(err) != codes.Internal {
+		t.Errorf("code = %v, want %v", status.Code(err), codes.Internal)
+	}
+
+	ok := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "done", nil
+	}
+	resp, err = interceptor(context.Background(), nil, info, ok)
+	if err != nil || resp != "done" {
+		t.Errorf("interceptor(ok) = (%v, %v), want (done, nil)", resp, err)
+	}
+}
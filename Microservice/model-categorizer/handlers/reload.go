@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/chat-api/model-categorizer/classifiers"
+	"github.com/chat-api/model-categorizer/models/proto"
+)
+
+// classifierState is the reloadable part of the handler. It is immutable once built;
+// a reload stores a new one, so requests that loaded the old state finish with it.
+type classifierState struct {
+	classifier         *classifiers.ModelClassifier
+	familyDisplayNames map[string]string
+	generation         uint64 // Increases with every reload; keys cached responses
+}
+
+// newClassifierState builds a classifier from opts alongside its family labels
+func newClassifierState(opts []classifiers.Option, familyDisplayNames map[string]string, generation uint64) *classifierState {
+	return &classifierState{
+		classifier:         classifiers.NewModelClassifier(opts...),
+		familyDisplayNames: familyDisplayNames,
+		generation:         generation,
+	}
+}
+
+// familyDisplayName returns the configured label for a family, defaulting to the family itself
+func (s *classifierState) familyDisplayName(family string) string {
+	if name, ok := s.familyDisplayNames[family]; ok && name != "" {
+		return name
+	}
+	return family
+}
+
+// classifier returns the live classifier. Callers that classify several models should
+// load it once so a concurrent reload can't mix configurations within one response.
+func (h *ModelClassificationHandler) classifier() *classifiers.ModelClassifier {
+	return h.live.Load().classifier
+}
+
+// ReloadClassifier rebuilds the classifier from opts and swaps it in atomically.
+// Only classifier settings and family display names are read from opts; limits such
+// as WithMaxModelsPerRequest are fixed at startup and ignored here. Requests already
+// running keep the classifier they started with. Cached ClassifyModels responses are
+// dropped, but responses kept for request-ID retries are not, so a retry still
+// returns its original answer.
+func (h *ModelClassificationHandler) ReloadClassifier(opts ...Option) {
+	// Collect the settings on a scratch handler so the live one is never half-updated
+	scratch := &ModelClassificationHandler{}
+	for _, opt := range opts {
+		opt(scratch)
+	}
+
+	h.live.Store(newClassifierState(scratch.classifierOpts, scratch.familyDisplayNames, h.generations.Add(1)))
+	h.responses.clear()
+	slog.Info("Reloaded classifier configuration", "family_display_names", len(scratch.familyDisplayNames))
+}
+
+// errNoConfigLoader is returned by ReloadConfig when no WithConfigLoader was given
+var errNoConfigLoader = errors.New("no configuration loader is set")
+
+// ReloadConfig reads fresh options with the configured loader and reloads the
+// classifier with them. A loader error keeps the running configuration.
+func (h *ModelClassificationHandler) ReloadConfig() error {
+	if h.loadConfig == nil {
+		return errNoConfigLoader
+	}
+
+	h.reloadMu.Lock()
+	defer h.reloadMu.Unlock()
+
+	opts, err := h.loadConfig()
+	if err != nil {
+		return err
+	}
+	h.ReloadClassifier(opts...)
+	return nil
+}
+
+// ReloadConfiguration is the admin RPC for ReloadConfig. Callers must present the
+// admin token; without WithAdminToken the RPC is disabled.
+func (h *ModelClassificationHandler) ReloadConfiguration(ctx context.Context, req *proto.ReloadConfigurationRequest) (*proto.ReloadConfigurationResponse, error) {
+	if h.adminToken == "" {
+		return nil, status.Error(codes.PermissionDenied, "configuration reload is disabled: no admin token is configured")
+	}
+	if !h.authorizedAdmin(ctx) {
+		return nil, status.Error(codes.Unauthenticated, "a valid admin token is required")
+	}
+
+	if err := h.ReloadConfig(); err != nil {
+		slog.Error("Failed to reload classifier configuration; keeping the current one", "error", err)
+		return nil, status.Errorf(codes.FailedPrecondition, "reload configuration: %v", err)
+	}
+
+	live := h.live.Load()
+	return &proto.ReloadConfigurationResponse{
+		Generation:   live.generation,
+		PatternCount: int32(live.classifier.PatternCount()),
+	}, nil
+}
+
+// authorizedAdmin reports whether the request carries "authorization: Bearer <admin token>"
+func (h *ModelClassificationHandler) authorizedAdmin(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, value := range md.Get("authorization") {
+		token, found := strings.CutPrefix(value, "Bearer ")
+		if found && subtle.ConstantTimeCompare([]byte(token), []byte(h.adminToken)) == 1 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/chat-api/model-categorizer/classifiers"
+	"github.com/chat-api/model-categorizer/models/proto"
+)
+
+// TestReloadClassifierConcurrentWithClassification is meant to run under
+// go test -race: requests keep classifying while the configuration is swapped.
+func TestReloadClassifierConcurrentWithClassification(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	ids := []string{"gpt-4o", "claude-3-5-sonnet-20241022", "gemini-2.0-flash", "o3-mini", "llama-3.1-70b-instruct"}
+
+	const workers, iterations = 8, 50
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				id := ids[(w+i)%len(ids)]
+				if _, err := h.ClassifySingleModel(context.Background(), &proto.SingleModelRequest{Id: id}); err != nil {
+					t.Errorf("ClassifySingleModel(%q): %v", id, err)
+					return
+				}
+				if metadata := h.classifier().ClassifyModel(id, ""); metadata.Provider == "" {
+					t.Errorf("ClassifyModel(%q) returned no provider", id)
+					return
+				}
+				req := &proto.LoadedModelList{Models: []*proto.Model{{Id: id}, {Id: ids[i%len(ids)]}}}
+				if _, err := h.ClassifyModels(context.Background(), req); err != nil {
+					t.Errorf("ClassifyModels: %v", err)
+					return
+				}
+			}
+		}(w)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < iterations; i++ {
+			if i%2 == 0 {
+				h.ReloadClassifier(WithExperimentalOverrides([]string{"o3-mini"}, []string{"gpt-4o"}),
+					WithFamilyDisplayNames(map[string]string{"GPT": "OpenAI GPT"}))
+			} else {
+				h.ReloadClassifier()
+			}
+		}
+	}()
+
+	wg.Wait()
+}
+
+func TestReloadConfigurationUsesChangedPatternFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "patterns.json")
+	writePatterns := func(content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	loadConfig := func() ([]Option, error) {
+		patterns, err := classifiers.LoadPatternFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return []Option{WithPatterns(patterns)}, nil
+	}
+	writePatterns(`{}`)
+	patterns, err := classifiers.LoadPatternFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewModelClassificationHandler(false, WithAdminToken("secret"), WithConfigLoader(loadConfig), WithPatterns(patterns))
+
+	provider := func() string {
+		t.Helper()
+		model, err := h.ClassifySingleModel(context.Background(), &proto.SingleModelRequest{Id: "grok-2"})
+		if err != nil {
+			t.Fatalf("ClassifySingleModel: %v", err)
+		}
+		return model.Provider
+	}
+	if got := provider(); got != classifiers.ProviderOther {
+		t.Fatalf("before reload: provider = %q, want %q", got, classifiers.ProviderOther)
+	}
+
+	writePatterns(`{"providers": {"xai": ["grok"]}}`)
+	if got := provider(); got != classifiers.ProviderOther {
+		t.Fatalf("a changed file must not apply until reload: provider = %q", got)
+	}
+
+	admin := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer secret"))
+	resp, err := h.ReloadConfiguration(admin, &proto.ReloadConfigurationRequest{})
+	if err != nil {
+		t.Fatalf("ReloadConfiguration: %v", err)
+	}
+	if resp.Generation < 2 {
+		t.Errorf("generation = %d, want it to advance past the initial state", resp.Generation)
+	}
+	if got := provider(); got != "xai" {
+		t.Errorf("after reload: provider = %q, want %q", got, "xai")
+	}
+
+	// A broken file keeps the running configuration
+	writePatterns(`{"providers": [`)
+	if _, err := h.ReloadConfiguration(admin, &proto.ReloadConfigurationRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("reload with a broken file: code = %v, want %v", status.Code(err), codes.FailedPrecondition)
+	}
+	if got := provider(); got != "xai" {
+		t.Errorf("after a failed reload: provider = %q, want %q", got, "xai")
+	}
+}
+
+func TestReloadConfigurationRequiresAdminToken(t *testing.T) {
+	loadConfig := func() ([]Option, error) { return nil, nil }
+
+	tests := []struct {
+		name     string
+		token    string
+		auth     []string
+		wantCode codes.Code
+	}{
+		{"disabled without a token", "", []string{"Bearer secret"}, codes.PermissionDenied},
+		{"missing credentials", "secret", nil, codes.Unauthenticated},
+		{"wrong token", "secret", []string{"Bearer wrong"}, codes.Unauthenticated},
+		{"missing bearer scheme", "secret", []string{"secret"}, codes.Unauthenticated},
+		{"valid token", "secret", []string{"Bearer secret"}, codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewModelClassificationHandler(false, WithAdminToken(tt.token), WithConfigLoader(loadConfig))
+			ctx := context.Background()
+			if tt.auth != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.auth[0]))
+			}
+
+			_, err := h.ReloadConfiguration(ctx, &proto.ReloadConfigurationRequest{})
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("code = %v, want %v", status.Code(err), tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/chat-api/model-categorizer/models/proto"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// defaultResponseCacheTTL is how long a built ClassifyModels response is reused
+const defaultResponseCacheTTL = 5 * time.Minute
+
+// defaultResponseCacheSize caps the entries of each response cache; the least recently
+// used entry is evicted first, so clients sending many distinct lists can't grow memory
+const defaultResponseCacheSize = 256
+
+// cachedResponse is a stored response with its key and expiry time
+type cachedResponse struct {
+	key       string
+	response  *proto.ClassifiedModelResponse
+	expiresAt time.Time
+}
+
+// responseCache is a TTL and size bounded LRU cache of ClassifyModels responses.
+// Cached responses are shared between concurrent callers and must not be modified.
+type responseCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	order      *list.List // Most recently used at the front
+	entries    map[string]*list.Element
+}
+
+// defaultRequestIDTTL is how long completed responses are kept for retries carrying the same request ID
+const defaultRequestIDTTL = 2 * time.Minute
+
+// newResponseCache creates a response cache with the given TTL holding at most maxEntries
+func newResponseCache(ttl time.Duration, maxEntries int) *responseCache {
+	return &responseCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		order:      list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+// get returns the cached response for key if it has not expired
+func (c *responseCache) get(key string) (*proto.ClassifiedModelResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*cachedResponse)
+	if time.Now().After(entry.expiresAt) {
+		c.remove(elem)
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return entry.response, true
+}
+
+// set stores a response under key, evicting the least recently used entries over the cap
+func (c *responseCache) set(key string, response *proto.ClassifiedModelResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(c.ttl)
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*cachedResponse)
+		entry.response, entry.expiresAt = response, expiresAt
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&cachedResponse{key: key, response: response, expiresAt: expiresAt})
+	for c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		c.remove(c.order.Back())
+	}
+}
+
+// remove drops one entry; the caller holds the lock
+func (c *responseCache) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*cachedResponse).key)
+}
+
+// size returns the number of cached entries, including expired ones not yet evicted
+func (c *responseCache) size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// clear drops every cached response
+func (c *responseCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.order.Init()
+	c.entries = make(map[string]*list.Element)
+}
+
+// modelListCacheKey hashes the whole request, since any model field can change the
+// classification, and prefixes the classifier generation that will answer it. The
+// request ID and field mask are left out: they don't change the cached (unmasked) response.
+func modelListCacheKey(req *proto.LoadedModelList, generation uint64) string {
+	keyed := protobuf.Clone(req).(*proto.LoadedModelList)
+	keyed.RequestId = ""
+	keyed.Fields = nil
+
+	data, err := protobuf.MarshalOptions{Deterministic: true}.Marshal(keyed)
+	if err != nil {
+		// Unreachable for a well-formed message; an empty key just never matches
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return strconv.FormatUint(generation, 10) + ":" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	protobuf "google.golang.org/protobuf/proto"
+
+	"github.com/chat-api/model-categorizer/models/proto"
+)
+
+func TestModelListCacheKey(t *testing.T) {
+	base := func() *proto.LoadedModelList {
+		return &proto.LoadedModelList{
+			Models: []*proto.Model{{Id: "gpt-4o", Provider: "openai"}},
+		}
+	}
+	baseKey := modelListCacheKey(base(), 1)
+
+	tests := []struct {
+		name     string
+		mutate   func(*proto.LoadedModelList)
+		wantSame bool
+	}{
+		{"identical request", func(*proto.LoadedModelList) {}, true},
+		{"request ID", func(r *proto.LoadedModelList) { r.RequestId = "retry-1" }, true},
+		{"field mask", func(r *proto.LoadedModelList) { r.Fields = []string{"id"} }, true},
+		{"name", func(r *proto.LoadedModelList) { r.Models[0].Name = "OpenAI: GPT-4o" }, false},
+		{"context size", func(r *proto.LoadedModelList) { r.Models[0].ContextSize = 4096 }, false},
+		{"display name", func(r *proto.LoadedModelList) { r.Models[0].DisplayName = "Custom" }, false},
+		{"base model", func(r *proto.LoadedModelList) { r.Models[0].BaseModel = "gpt-4o-mini" }, false},
+		{"metadata", func(r *proto.LoadedModelList) { r.Models[0].Metadata = map[string]string{"tier": "pro"} }, false},
+		{"tags", func(r *proto.LoadedModelList) { r.Models[0].Tags = []string{"coding"} }, false},
+		{"default model", func(r *proto.LoadedModelList) { r.DefaultModel = "gpt-4o" }, false},
+		{"keep duplicates", func(r *proto.LoadedModelList) { r.KeepDuplicates = true }, false},
+		{"skip enhancement", func(r *proto.LoadedModelList) { r.SkipEnhancement = true }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := base()
+			tt.mutate(req)
+			if same := modelListCacheKey(req, 1) == baseKey; same != tt.wantSame {
+				t.Errorf("key unchanged = %v, want %v", same, tt.wantSame)
+			}
+		})
+	}
+}
+
+func TestModelListCacheKeyIncludesGeneration(t *testing.T) {
+	req := &proto.LoadedModelList{Models: []*proto.Model{{Id: "gpt-4o"}}}
+	if modelListCacheKey(req, 1) == modelListCacheKey(req, 2) {
+		t.Error("keys for different classifier generations are equal")
+	}
+}
+
+func TestClassifyModelsCacheNotReusedAfterReload(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	request := func() *proto.LoadedModelList {
+		return &proto.LoadedModelList{Models: []*proto.Model{{Id: "gpt-4o", Provider: "openai"}}}
+	}
+	experimentalCount := func() int32 {
+		t.Helper()
+		resp, err := h.ClassifyModels(context.Background(), request())
+		if err != nil {
+			t.Fatalf("ClassifyModels: %v", err)
+		}
+		return resp.Summary.ExperimentalCount
+	}
+
+	if got := experimentalCount(); got != 0 {
+		t.Fatalf("before reload: experimental count = %d, want 0", got)
+	}
+	stale := h.live.Load()
+
+	h.ReloadClassifier(WithExperimentalOverrides(nil, []string{"gpt-4o"}))
+
+	// A request that started before the reload finishes after it and caches its result
+	h.responses.set(modelListCacheKey(request(), stale.generation), &proto.ClassifiedModelResponse{
+		Summary: &proto.ClassificationSummary{ExperimentalCount: 0},
+	})
+
+	if got := experimentalCount(); got != 1 {
+		t.Errorf("after reload: experimental count = %d, want 1 from the reloaded classifier", got)
+	}
+}
+
+func TestClassifyModelsCacheMissOnChangedField(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	request := func(contextSize int32) *proto.LoadedModelList {
+		return &proto.LoadedModelList{
+			Models: []*proto.Model{{Id: "gpt-4o", Provider: "openai", ContextSize: contextSize}},
+		}
+	}
+
+	for _, contextSize := range []int32{4096, 8192} {
+		resp, err := h.ClassifyModels(context.Background(), request(contextSize))
+		if err != nil {
+			t.Fatalf("ClassifyModels: %v", err)
+		}
+		if got := resp.Summary.MaxContextSize; got != contextSize {
+			t.Errorf("context size %d: response reports %d, want the request's own value", contextSize, got)
+		}
+	}
+}
+
+func TestResponseCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newResponseCache(time.Minute, 2)
+	a, b, c := &proto.ClassifiedModelResponse{}, &proto.ClassifiedModelResponse{}, &proto.ClassifiedModelResponse{}
+
+	cache.set("a", a)
+	cache.set("b", b)
+	cache.get("a") // a is now more recent than b
+	cache.set("c", c)
+
+	if cache.size() != 2 {
+		t.Errorf("size = %d, want 2", cache.size())
+	}
+	if _, ok := cache.get("b"); ok {
+		t.Error("least recently used entry b was not evicted")
+	}
+	if got, ok := cache.get("a"); !ok || got != a {
+		t.Error("recently used entry a was evicted")
+	}
+	if got, ok := cache.get("c"); !ok || got != c {
+		t.Error("newest entry c is missing")
+	}
+}
+
+func TestResponseCacheExpires(t *testing.T) {
+	cache := newResponseCache(time.Nanosecond, 2)
+	cache.set("a", &proto.ClassifiedModelResponse{})
+	time.Sleep(time.Millisecond)
+
+	if _, ok := cache.get("a"); ok {
+		t.Error("expired entry was returned")
+	}
+	if cache.size() != 0 {
+		t.Errorf("size = %d, want expired entry removed", cache.size())
+	}
+}
+
+func TestClassifyModelsRetryWithRequestID(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	request := func(ids ...string) *proto.LoadedModelList {
+		req := &proto.LoadedModelList{RequestId: "retry-1"}
+		for _, id := range ids {
+			req.Models = append(req.Models, &proto.Model{Id: id})
+		}
+		return req
+	}
+
+	var first, retry *proto.ClassifiedModelResponse
+	var firstErr, retryErr error
+	spans := recordSpans(func() {
+		first, firstErr = h.ClassifyModels(context.Background(), request("gpt-4o", "claude-3-5-sonnet-20241022"))
+		retry, retryErr = h.ClassifyModels(context.Background(), request("gpt-4o", "claude-3-5-sonnet-20241022"))
+	})
+	if firstErr != nil || retryErr != nil {
+		t.Fatalf("ClassifyModels: %v, retry: %v", firstErr, retryErr)
+	}
+	if !protobuf.Equal(first, retry) {
+		t.Error("retried request returned a different response")
+	}
+
+	enhancements := 0
+	for _, name := range spans {
+		if name == "enhanceModels" {
+			enhancements++
+		}
+	}
+	if enhancements != 1 {
+		t.Errorf("models enhanced %d times, want 1", enhancements)
+	}
+
+	// The request ID alone identifies a retry, even if the resent body differs
+	changed, err := h.ClassifyModels(context.Background(), request("gemini-2.0-flash"))
+	if err != nil {
+		t.Fatalf("ClassifyModels with a reused request ID: %v", err)
+	}
+	if !protobuf.Equal(first, changed) {
+		t.Error("reused request ID was classified again instead of returning the completed response")
+	}
+}
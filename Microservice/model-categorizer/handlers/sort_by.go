@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/chat-api/model-categorizer/models"
+)
+
+// Supported values for ClassificationCriteria.SortBy
+const (
+	SortByContextDesc = "context_desc"
+	SortByContextAsc  = "context_asc"
+	SortByName        = "name"
+	SortByReleaseDate = "release_date"
+)
+
+// Supported values for ClassificationCriteria.Format
+const (
+	FormatGroups = "groups"
+	FormatMap    = "map"
+)
+
+// validateFormat rejects unknown response formats; an empty value means FormatGroups
+func validateFormat(format string) error {
+	switch format {
+	case "", FormatGroups, FormatMap:
+		return nil
+	default:
+		return fmt.Errorf("unknown format %q (expected %s or %s)", format, FormatGroups, FormatMap)
+	}
+}
+
+// validateSortBy rejects unknown sort orders; an empty value keeps the default ordering
+func validateSortBy(sortBy string) error {
+	switch sortBy {
+	case "", SortByContextDesc, SortByContextAsc, SortByName, SortByReleaseDate:
+		return nil
+	default:
+		return fmt.Errorf("unknown sort_by %q (expected %s, %s, %s or %s)",
+			sortBy, SortByContextDesc, SortByContextAsc, SortByName, SortByReleaseDate)
+	}
+}
+
+// sortModelsBy reorders models according to a SortBy value. The sort is stable so
+// models that compare equal keep their existing order. Release dates come from the
+// request's classifier snapshot live.
+func (h *ModelClassificationHandler) sortModelsBy(modelsList []*models.Model, sortBy string, live *classifierState) {
+	var less func(a, b *models.Model) bool
+
+	switch sortBy {
+	case SortByContextDesc:
+		less = func(a, b *models.Model) bool { return a.ContextSize > b.ContextSize }
+	case SortByContextAsc:
+		less = func(a, b *models.Model) bool { return a.ContextSize < b.ContextSize }
+	case SortByName:
+		less = func(a, b *models.Model) bool { return strings.ToLower(modelName(a)) < strings.ToLower(modelName(b)) }
+	case SortByReleaseDate:
+		// Newest first; models without a known date go last
+		less = func(a, b *models.Model) bool { return releaseDate(a, live) > releaseDate(b, live) }
+	default:
+		return
+	}
+
+	sort.SliceStable(modelsList, func(i, j int) bool {
+		return less(modelsList[i], modelsList[j])
+	})
+}
+
+// modelName returns the name used for name ordering
+func modelName(model *models.Model) string {
+	if model.DisplayName != "" {
+		return model.DisplayName
+	}
+	if model.Name != "" {
+		return model.Name
+	}
+	return model.ID
+}
+
+// releaseDate returns a sortable YYYY-MM-DD release date for a model, taken from the
+// "release_date" metadata or a full date in the model's snapshot suffix
+func releaseDate(model *models.Model, live *classifierState) string {
+	if date := model.Metadata["release_date"]; date != "" {
+		return date
+	}
+
+	_, snapshot := live.classifier.ResolveSnapshot(model.ID)
+	switch {
+	case len(snapshot) == 10:
+		return snapshot
+	case len(snapshot) == 8:
+		return snapshot[:4] + "-" + snapshot[4:6] + "-" + snapshot[6:]
+	default:
+		// Short snapshots such as "0613" don't carry a year and can't be ordered
+		return ""
+	}
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"github.com/chat-api/model-categorizer/models"
+	"github.com/chat-api/model-categorizer/models/proto"
+)
+
+// sortByTestModels are unrecognized models, so they all land in one provider group and
+// keep the name, context size and release date given here
+func sortByTestModels() []*models.Model {
+	return []*models.Model{
+		{ID: "acme-bravo", Name: "Bravo", Provider: "acme", ContextSize: 32000,
+			Metadata: map[string]string{"release_date": "2024-03-01"}},
+		{ID: "acme-alpha", Name: "alpha", Provider: "acme", ContextSize: 128000,
+			Metadata: map[string]string{"release_date": "2023-01-15"}},
+		{ID: "acme-charlie-2024-06-01", Name: "Charlie", Provider: "acme", ContextSize: 8000},
+		{ID: "acme-delta", Name: "delta", Provider: "acme", ContextSize: 64000},
+	}
+}
+
+// flatModelIDs returns the IDs of the models in the first flat group, in order
+func flatModelIDs(resp *proto.ClassifiedModelResponse) []string {
+	var ids []string
+	if len(resp.ClassifiedGroups) > 0 {
+		for _, model := range resp.ClassifiedGroups[0].Models {
+			ids = append(ids, model.Id)
+		}
+	}
+	return ids
+}
+
+func TestClassifyModelsWithCriteriaSortBy(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+
+	tests := []struct {
+		sortBy string
+		want   []string
+	}{
+		{SortByContextDesc, []string{"acme-alpha", "acme-delta", "acme-bravo", "acme-charlie-2024-06-01"}},
+		{SortByContextAsc, []string{"acme-charlie-2024-06-01", "acme-bravo", "acme-delta", "acme-alpha"}},
+		{SortByName, []string{"acme-alpha", "acme-bravo", "acme-charlie-2024-06-01", "acme-delta"}},
+		// Newest first, the snapshot date counting as a release date; undated models last
+		{SortByReleaseDate, []string{"acme-charlie-2024-06-01", "acme-bravo", "acme-alpha", "acme-delta"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sortBy, func(t *testing.T) {
+			req := &proto.ClassificationCriteria{Properties: []string{PropertyProvider}, SortBy: tt.sortBy}
+			resp, err := h.ClassifyModelsWithCriteria(criteriaContext(sortByTestModels()...), req)
+			if err != nil {
+				t.Fatalf("ClassifyModelsWithCriteria: %v", err)
+			}
+			if len(resp.ClassifiedGroups) != 1 {
+				t.Fatalf("got %d groups, want the models in one provider group", len(resp.ClassifiedGroups))
+			}
+			if got := flatModelIDs(resp); !equalStrings(got, tt.want) {
+				t.Errorf("order = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifyModelsWithCriteriaRejectsUnknownSortBy(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	req := &proto.ClassificationCriteria{SortBy: "context"}
+
+	_, err := h.ClassifyModelsWithCriteria(criteriaContext(sortByTestModels()...), req)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestClassifyModelsWithCriteriaHierarchyIgnoresSortBy(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	classify := func(sortBy string) *proto.ClassifiedModelResponse {
+		t.Helper()
+		req := &proto.ClassificationCriteria{Hierarchical: true, SortBy: sortBy}
+		resp, err := h.ClassifyModelsWithCriteria(criteriaContext(sortByTestModels()...), req)
+		if err != nil {
+			t.Fatalf("ClassifyModelsWithCriteria(sort_by %q): %v", sortBy, err)
+		}
+		return resp
+	}
+
+	unsorted := classify("")
+	for _, sortBy := range []string{SortByContextDesc, SortByContextAsc, SortByName, SortByReleaseDate} {
+		if sorted := classify(sortBy); !protobuf.Equal(sorted, unsorted) {
+			t.Errorf("sort_by %q changed the hierarchical response", sortBy)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chat-api/model-categorizer/classifiers"
+	"github.com/chat-api/model-categorizer/models"
+)
+
+func TestSortModelsUsesClassifiedOpenAIType(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	modelsList := []*models.Model{
+		{ID: "omni-moderation-latest", Name: "omni-moderation-latest", Provider: "openai", Type: classifiers.TypeStandard},
+		{ID: "gpt-4", Name: "gpt-4", Provider: "openai", Type: classifiers.Type4},
+		{ID: "o1", Name: "o1", Provider: "openai", Type: classifiers.TypeO},
+	}
+
+	h.sortModels(context.Background(), modelsList, "", false)
+
+	// omni-moderation is not an O-series model, so it sorts after GPT-4 with the other OpenAI models
+	want := []string{"o1", "gpt-4", "omni-moderation-latest"}
+	for i, model := range modelsList {
+		if model.ID != want[i] {
+			t.Fatalf("sorted order[%d] = %q, want %q (full order %v)", i, model.ID, want[i], want)
+		}
+	}
+}
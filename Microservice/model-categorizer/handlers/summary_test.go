@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/chat-api/model-categorizer/models"
+)
+
+func TestClassifyModelsSummaryMatchesModels(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	ctx := criteriaContext(
+		&models.Model{ID: "gpt-4o", Provider: "openai"},
+		&models.Model{ID: "gpt-4o-mini", Provider: "openai"},
+		&models.Model{ID: "claude-3-5-sonnet-20241022", Provider: "anthropic"},
+		&models.Model{ID: "gemini-2.0-flash-exp", Provider: "google"},
+		&models.Model{ID: "text-embedding-3-small", Provider: "openai"},
+		&models.Model{ID: "custom-model", Provider: "acme", ContextSize: 4096},
+	)
+
+	resp, err := h.ClassifyModelsWithCriteria(ctx, nil)
+	if err != nil {
+		t.Fatalf("ClassifyModelsWithCriteria: %v", err)
+	}
+
+	// Count the returned models by hand and compare with the summary
+	byID := protoHierarchyModels(resp.HierarchicalGroups, nil)
+	providers := make(map[string]int32)
+	types := make(map[string]int32)
+	capabilities := make(map[string]int32)
+	var multimodal, experimental, minContext, maxContext int32
+	for _, model := range byID {
+		providers[model.Provider]++
+		types[model.Type]++
+		for _, capability := range model.Capabilities {
+			capabilities[capability]++
+		}
+		if model.IsMultimodal {
+			multimodal++
+		}
+		if model.IsExperimental {
+			experimental++
+		}
+		if model.ContextSize > 0 && (minContext == 0 || model.ContextSize < minContext) {
+			minContext = model.ContextSize
+		}
+		if model.ContextSize > maxContext {
+			maxContext = model.ContextSize
+		}
+	}
+
+	summary := resp.Summary
+	if int(summary.TotalModels) != len(byID) || len(byID) != 6 {
+		t.Errorf("TotalModels = %d, models in response = %d, want 6", summary.TotalModels, len(byID))
+	}
+	if !reflect.DeepEqual(summary.ProviderCounts, providers) {
+		t.Errorf("ProviderCounts = %v, want %v", summary.ProviderCounts, providers)
+	}
+	if !reflect.DeepEqual(summary.TypeCounts, types) {
+		t.Errorf("TypeCounts = %v, want %v", summary.TypeCounts, types)
+	}
+	if !reflect.DeepEqual(summary.CapabilityCounts, capabilities) {
+		t.Errorf("CapabilityCounts = %v, want %v", summary.CapabilityCounts, capabilities)
+	}
+	if summary.MultimodalCount != multimodal || multimodal == 0 {
+		t.Errorf("MultimodalCount = %d, want %d (non-zero)", summary.MultimodalCount, multimodal)
+	}
+	if summary.ExperimentalCount != experimental || experimental == 0 {
+		t.Errorf("ExperimentalCount = %d, want %d (non-zero)", summary.ExperimentalCount, experimental)
+	}
+	if summary.MinContextSize != minContext || summary.MaxContextSize != maxContext {
+		t.Errorf("context range = [%d, %d], want [%d, %d]",
+			summary.MinContextSize, summary.MaxContextSize, minContext, maxContext)
+	}
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/chat-api/model-categorizer/models/proto"
+)
+
+var (
+	spanRecorderOnce sync.Once
+	spanRecorder     *tracetest.SpanRecorder
+)
+
+// recordSpans returns the names of the spans ended while fn runs. The package tracer
+// delegates to the first global provider that is set, so all tests share one recorder.
+func recordSpans(fn func()) []string {
+	spanRecorderOnce.Do(func() {
+		spanRecorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder)))
+	})
+
+	before := len(spanRecorder.Ended())
+	fn()
+
+	var names []string
+	for _, span := range spanRecorder.Ended()[before:] {
+		names = append(names, span.Name())
+	}
+	return names
+}
+
+func TestClassifyModelsEmitsSpans(t *testing.T) {
+	h := NewModelClassificationHandler(false)
+	req := &proto.LoadedModelList{Models: []*proto.Model{{Id: "gpt-4o"}, {Id: "claude-3-5-sonnet-20241022"}}}
+
+	var err error
+	spans := recordSpans(func() {
+		_, err = h.ClassifyModels(context.Background(), req)
+	})
+	if err != nil {
+		t.Fatalf("ClassifyModels: %v", err)
+	}
+
+	emitted := make(map[string]bool)
+	for _, name := range spans {
+		emitted[name] = true
+	}
+	for _, name := range []string{"enhanceModels", "sortModels", "buildModelHierarchy"} {
+		if !emitted[name] {
+			t.Errorf("no %q span recorded; got %v", name, spans)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package logging
+
+import (
+	"io"
+	"log/slog"
+	"strings"
+)
+
+// ParseLevel converts a LOG_LEVEL value into a slog level, defaulting to info
+func ParseLevel(value string) slog.Level {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// NewLogger creates a human-readable text logger writing to w at the given level
+func NewLogger(w io.Writer, level slog.Level) *slog.Logger {
+	return slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{Level: level}))
+}
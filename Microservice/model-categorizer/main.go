@@ -1,22 +1,32 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
-	"log"
+	"log/slog"
 	"net"
 	"os"
 	"os/signal"
+	"reflect"
+	"sort"
+	"strings"
 	"syscall"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/chat-api/model-categorizer/classifiers"
 	"github.com/chat-api/model-categorizer/handlers"
+	"github.com/chat-api/model-categorizer/logging"
 	"github.com/chat-api/model-categorizer/models/proto"
+	"github.com/chat-api/model-categorizer/telemetry"
+	"github.com/chat-api/model-categorizer/version"
 )
 
 const (
@@ -27,8 +37,26 @@ func main() {
 	// Parse command line flags
 	enableLogging := flag.Bool("log", false, "Enable detailed request/response logging")
 	port := flag.String("port", defaultPort, "Port to listen on")
+	maxModels := flag.Int("max-models", handlers.DefaultMaxModelsPerRequest, "Maximum number of models accepted per request (0 disables the limit)")
+	showVersion := flag.Bool("version", false, "Print build information and exit")
+	maxConcurrent := flag.Int("max-concurrent", handlers.DefaultConcurrentRequestLimit, "Maximum number of in-flight classification requests (0 disables the limit)")
+	concurrencyWait := flag.Duration("concurrency-wait", handlers.DefaultConcurrencyWait, "How long a request waits for a free slot before being rejected")
+	maxDepth := flag.Int("max-hierarchy-depth", handlers.DefaultMaxHierarchyDepth, "Maximum number of hierarchy levels; deeper dimensions are not split (0 disables the cap)")
+	gzipMinSize := flag.Int("gzip-min-size", handlers.DefaultCompressionThreshold, "Smallest response in bytes that is gzip-compressed for clients accepting gzip")
 	flag.Parse()
 
+	if *showVersion {
+		fmt.Println("model-categorizer", version.String())
+		return
+	}
+
+	// Configure structured logging; -log bumps the level to debug
+	logLevel := logging.ParseLevel(os.Getenv("LOG_LEVEL"))
+	if *enableLogging {
+		logLevel = slog.LevelDebug
+	}
+	slog.SetDefault(logging.NewLogger(os.Stderr, logLevel))
+
 	// Get port from environment or use default
 	/* envPort := os.Getenv("PORT")
 	if envPort != "" {
@@ -38,7 +66,15 @@ func main() {
 	// Create listener
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", *port))
 	if err != nil {
-		log.Fatalf("Failed to listen: %v", err)
+		slog.Error("Failed to listen", "port", *port, "error", err)
+		os.Exit(1)
+	}
+
+	// Set up tracing (no-op unless an OTLP endpoint is configured)
+	shutdownTracing, err := telemetry.InitTracing(context.Background(), os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"))
+	if err != nil {
+		slog.Error("Failed to initialize tracing", "error", err)
+		os.Exit(1)
 	}
 
 	// Create server options
@@ -46,7 +82,18 @@ func main() {
 		grpc.MaxRecvMsgSize(50 * 1024 * 1024), // 50MB
 		grpc.MaxSendMsgSize(50 * 1024 * 1024), // 50MB
 		grpc.Creds(insecure.NewCredentials()),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 	}
+	// Recovery runs outermost so it also catches panics in the other interceptors
+	interceptors := []grpc.UnaryServerInterceptor{
+		handlers.RecoveryInterceptor(),
+		handlers.CompressionInterceptor(*gzipMinSize),
+	}
+	if *maxConcurrent > 0 {
+		limiter := handlers.NewConcurrencyLimiter(*maxConcurrent, *concurrencyWait)
+		interceptors = append(interceptors, limiter.UnaryServerInterceptor())
+	}
+	opts = append(opts, grpc.ChainUnaryInterceptor(interceptors...))
 
 	// Create a new gRPC server
 	grpcServer := grpc.NewServer(opts...)
@@ -57,7 +104,31 @@ func main() {
 	healthServer.SetServingStatus("modelservice.ModelClassificationService", healthpb.HealthCheckResponse_SERVING)
 
 	// Register our service handler
-	handler := handlers.NewModelClassificationHandler(*enableLogging)
+	classifierConfig, err := loadClassifierConfig()
+	if err != nil {
+		slog.Error("Failed to load classifier configuration", "error", err)
+		os.Exit(1)
+	}
+
+	// Reloads (SIGHUP or the admin RPC) re-read the configuration; the handler runs them
+	// one at a time, and a bad file keeps the running config
+	loadConfig := func() ([]handlers.Option, error) {
+		updated, err := loadClassifierConfig()
+		if err != nil {
+			return nil, err
+		}
+		classifierConfig.logChanges(updated)
+		classifierConfig = updated
+		return updated.options(), nil
+	}
+
+	handlerOpts := append([]handlers.Option{
+		handlers.WithMaxModelsPerRequest(*maxModels),
+		handlers.WithMaxHierarchyDepth(*maxDepth),
+		handlers.WithAdminToken(os.Getenv("ADMIN_TOKEN")),
+		handlers.WithConfigLoader(loadConfig),
+	}, classifierConfig.options()...)
+	handler := handlers.NewModelClassificationHandler(*enableLogging, handlerOpts...)
 
 	// Register the service with gRPC server
 	proto.RegisterModelClassificationServiceServer(grpcServer, handler)
@@ -66,23 +137,42 @@ func main() {
 	reflection.Register(grpcServer)
 
 	// Log service startup
-	fmt.Printf("Model Classification Service starting on port %s...\n", *port)
+	slog.Info("Model Classification Service starting",
+		"port", *port,
+		"version", version.Version,
+		"commit", version.Commit,
+		"build_date", version.BuildDate)
 	if *enableLogging {
-		log.Printf("Detailed request/response logging is enabled")
+		slog.Info("Detailed request/response logging is enabled")
 	}
 
+	// Re-read the classifier configuration on SIGHUP
+	go func() {
+		hupCh := make(chan os.Signal, 1)
+		signal.Notify(hupCh, syscall.SIGHUP)
+		for range hupCh {
+			if err := handler.ReloadConfig(); err != nil {
+				slog.Error("Failed to reload classifier configuration; keeping the current one", "error", err)
+			}
+		}
+	}()
+
 	// Handle graceful shutdown
 	go func() {
 		sigCh := make(chan os.Signal, 1)
 		signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 		<-sigCh
-		log.Println("Shutting down gRPC server...")
+		slog.Info("Shutting down gRPC server...")
 		grpcServer.GracefulStop()
+		if err := shutdownTracing(context.Background()); err != nil {
+			slog.Error("Failed to shut down tracing", "error", err)
+		}
 	}()
 
 	// Start serving
 	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
+		slog.Error("Failed to serve", "error", err)
+		os.Exit(1)
 	}
 }
 
@@ -94,3 +184,146 @@ func main() {
 // 4. Add metrics collection
 // 5. Add health checks
 // 6. Add graceful shutdown
+
+// classifierConfig holds the settings that can be reloaded without a restart.
+// Environment variables are fixed for the process, so a reload picks up edits to the
+// files they point at: "@file" lists, FAMILY_DISPLAY_NAMES_FILE and PATTERNS_FILE.
+type classifierConfig struct {
+	forceStable          []string
+	forceExperimental    []string
+	experimentalKeywords []string
+	familyDisplayNames   map[string]string
+	patterns             classifiers.PatternFile
+}
+
+// loadClassifierConfig reads the classifier settings from the environment
+func loadClassifierConfig() (classifierConfig, error) {
+	var config classifierConfig
+	var err error
+
+	if config.forceStable, err = envList("FORCE_STABLE"); err != nil {
+		return config, fmt.Errorf("read FORCE_STABLE: %w", err)
+	}
+	if config.forceExperimental, err = envList("FORCE_EXPERIMENTAL"); err != nil {
+		return config, fmt.Errorf("read FORCE_EXPERIMENTAL: %w", err)
+	}
+	if config.experimentalKeywords, err = envList("EXPERIMENTAL_KEYWORDS"); err != nil {
+		return config, fmt.Errorf("read EXPERIMENTAL_KEYWORDS: %w", err)
+	}
+	if config.familyDisplayNames, err = loadFamilyDisplayNames(os.Getenv("FAMILY_DISPLAY_NAMES_FILE")); err != nil {
+		return config, fmt.Errorf("load family display names: %w", err)
+	}
+	if config.patterns, err = classifiers.LoadPatternFile(os.Getenv("PATTERNS_FILE")); err != nil {
+		return config, fmt.Errorf("load pattern file: %w", err)
+	}
+	return config, nil
+}
+
+// options converts the settings to handler options
+func (c classifierConfig) options() []handlers.Option {
+	return []handlers.Option{
+		handlers.WithExperimentalOverrides(c.forceStable, c.forceExperimental),
+		handlers.WithExperimentalKeywords(c.experimentalKeywords),
+		handlers.WithFamilyDisplayNames(c.familyDisplayNames),
+		handlers.WithPatterns(c.patterns),
+	}
+}
+
+// logChanges logs the entries added and removed by a reload, one line per changed setting
+func (c classifierConfig) logChanges(updated classifierConfig) {
+	lists := []struct {
+		name     string
+		old, new []string
+	}{
+		{"FORCE_STABLE", c.forceStable, updated.forceStable},
+		{"FORCE_EXPERIMENTAL", c.forceExperimental, updated.forceExperimental},
+		{"EXPERIMENTAL_KEYWORDS", c.experimentalKeywords, updated.experimentalKeywords},
+	}
+	for _, list := range lists {
+		if added, removed := diffLists(list.old, list.new); len(added) > 0 || len(removed) > 0 {
+			slog.Info("Classifier setting changed", "setting", list.name, "added", added, "removed", removed)
+		}
+	}
+
+	var changed []string
+	for family, name := range updated.familyDisplayNames {
+		if c.familyDisplayNames[family] != name {
+			changed = append(changed, family)
+		}
+	}
+	for family := range c.familyDisplayNames {
+		if _, ok := updated.familyDisplayNames[family]; !ok {
+			changed = append(changed, family)
+		}
+	}
+	if len(changed) > 0 {
+		sort.Strings(changed)
+		slog.Info("Classifier setting changed", "setting", "FAMILY_DISPLAY_NAMES_FILE", "families", changed)
+	}
+
+	if !reflect.DeepEqual(c.patterns, updated.patterns) {
+		slog.Info("Classifier setting changed", "setting", "PATTERNS_FILE",
+			"patterns_before", c.patterns.PatternCount(), "patterns_after", updated.patterns.PatternCount())
+	}
+}
+
+// diffLists returns the items only in updated and the items only in old
+func diffLists(old, updated []string) (added, removed []string) {
+	oldSet := make(map[string]bool, len(old))
+	for _, item := range old {
+		oldSet[item] = true
+	}
+	updatedSet := make(map[string]bool, len(updated))
+	for _, item := range updated {
+		updatedSet[item] = true
+		if !oldSet[item] {
+			added = append(added, item)
+		}
+	}
+	for _, item := range old {
+		if !updatedSet[item] {
+			removed = append(removed, item)
+		}
+	}
+	return added, removed
+}
+
+// envList reads a comma- or newline-separated list from an environment variable.
+// A value starting with "@" names a file to read the list from instead.
+func envList(name string) ([]string, error) {
+	value := os.Getenv(name)
+	if strings.HasPrefix(value, "@") {
+		data, err := os.ReadFile(value[1:])
+		if err != nil {
+			return nil, err
+		}
+		value = string(data)
+	}
+
+	var list []string
+	for _, item := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '\n' }) {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list, nil
+}
+
+// loadFamilyDisplayNames reads a JSON object mapping family values to display labels.
+// An empty path means no overrides.
+func loadFamilyDisplayNames(path string) (map[string]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var names map[string]string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return names, nil
+}
@@ -0,0 +1,115 @@
+package models
+
+import (
+	"sort"
+	"strconv"
+)
+
+// Comparison outcomes for a single field
+const (
+	ComparisonEqual    = "equal"
+	ComparisonAGreater = "a_greater"
+	ComparisonBGreater = "b_greater"
+	ComparisonDiffer   = "different" // Non-ordered fields whose values differ
+)
+
+// FieldComparison compares one field of two models
+type FieldComparison struct {
+	Field  string `json:"field"`
+	A      string `json:"a"`
+	B      string `json:"b"`
+	Result string `json:"result"`
+}
+
+// ComparisonResult is a side-by-side comparison of two classified models
+type ComparisonResult struct {
+	Fields             []FieldComparison `json:"fields"`
+	CommonCapabilities []string          `json:"common_capabilities,omitempty"`
+	OnlyACapabilities  []string          `json:"only_a_capabilities,omitempty"`
+	OnlyBCapabilities  []string          `json:"only_b_capabilities,omitempty"`
+}
+
+// CompareModels compares context size, pricing, multimodality and provider of two models
+// and splits their capabilities into shared and exclusive sets. Fields are reported in a
+// fixed order; capability lists are sorted.
+func CompareModels(a, b *Model) ComparisonResult {
+	result := ComparisonResult{
+		Fields: []FieldComparison{
+			compareNumber("context_size", float64(a.ContextSize), float64(b.ContextSize)),
+			compareNumber("max_tokens", float64(a.MaxTokens), float64(b.MaxTokens)),
+			compareNumber("cost_per_token", a.CostPerToken, b.CostPerToken),
+			compareBool("is_multimodal", a.IsMultimodal, b.IsMultimodal),
+			compareString("provider", a.Provider, b.Provider),
+			compareString("family", a.Family, b.Family),
+			compareString("type", a.Type, b.Type),
+		},
+	}
+
+	inB := make(map[string]bool, len(b.Capabilities))
+	for _, capability := range b.Capabilities {
+		inB[capability] = true
+	}
+	inA := make(map[string]bool, len(a.Capabilities))
+	for _, capability := range a.Capabilities {
+		if inA[capability] {
+			continue
+		}
+		inA[capability] = true
+		if inB[capability] {
+			result.CommonCapabilities = append(result.CommonCapabilities, capability)
+		} else {
+			result.OnlyACapabilities = append(result.OnlyACapabilities, capability)
+		}
+	}
+	for capability := range inB {
+		if !inA[capability] {
+			result.OnlyBCapabilities = append(result.OnlyBCapabilities, capability)
+		}
+	}
+
+	sort.Strings(result.CommonCapabilities)
+	sort.Strings(result.OnlyACapabilities)
+	sort.Strings(result.OnlyBCapabilities)
+	return result
+}
+
+func compareNumber(field string, a, b float64) FieldComparison {
+	comparison := FieldComparison{
+		Field: field,
+		A:     strconv.FormatFloat(a, 'g', -1, 64),
+		B:     strconv.FormatFloat(b, 'g', -1, 64),
+	}
+	switch {
+	case a > b:
+		comparison.Result = ComparisonAGreater
+	case b > a:
+		comparison.Result = ComparisonBGreater
+	default:
+		comparison.Result = ComparisonEqual
+	}
+	return comparison
+}
+
+// compareBool treats true as greater, so the multimodal model "wins"
+func compareBool(field string, a, b bool) FieldComparison {
+	comparison := FieldComparison{
+		Field:  field,
+		A:      strconv.FormatBool(a),
+		B:      strconv.FormatBool(b),
+		Result: ComparisonEqual,
+	}
+	if a && !b {
+		comparison.Result = ComparisonAGreater
+	} else if b && !a {
+		comparison.Result = ComparisonBGreater
+	}
+	return comparison
+}
+
+func compareString(field, a, b string) FieldComparison {
+	comparison := FieldComparison{Field: field, A: a, B: b, Result: ComparisonEqual}
+	if a != b {
+		comparison.Result = ComparisonDiffer
+	}
+	return comparison
+}
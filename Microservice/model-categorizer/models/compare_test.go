@@ -0,0 +1,43 @@
+package models
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCompareModels(t *testing.T) {
+	a := &Model{ID: "a", Provider: "openai", ContextSize: 128000, CostPerToken: 0.0000025, IsMultimodal: true,
+		Capabilities: []string{"vision", "chat", "function-calling", "chat"}}
+	b := &Model{ID: "b", Provider: "anthropic", ContextSize: 200000, CostPerToken: 0.0000025,
+		Capabilities: []string{"chat", "reasoning", "function-calling"}}
+
+	result := CompareModels(a, b)
+
+	want := map[string]string{
+		"context_size":   ComparisonBGreater,
+		"max_tokens":     ComparisonEqual,
+		"cost_per_token": ComparisonEqual,
+		"is_multimodal":  ComparisonAGreater,
+		"provider":       ComparisonDiffer,
+		"family":         ComparisonEqual,
+		"type":           ComparisonEqual,
+	}
+	if len(result.Fields) != len(want) {
+		t.Fatalf("got %d fields, want %d", len(result.Fields), len(want))
+	}
+	for _, field := range result.Fields {
+		if field.Result != want[field.Field] {
+			t.Errorf("%s = %q (a=%s, b=%s), want %q", field.Field, field.Result, field.A, field.B, want[field.Field])
+		}
+	}
+
+	if want := []string{"chat", "function-calling"}; !slices.Equal(result.CommonCapabilities, want) {
+		t.Errorf("CommonCapabilities = %v, want %v", result.CommonCapabilities, want)
+	}
+	if want := []string{"vision"}; !slices.Equal(result.OnlyACapabilities, want) {
+		t.Errorf("OnlyACapabilities = %v, want %v", result.OnlyACapabilities, want)
+	}
+	if want := []string{"reasoning"}; !slices.Equal(result.OnlyBCapabilities, want) {
+		t.Errorf("OnlyBCapabilities = %v, want %v", result.OnlyBCapabilities, want)
+	}
+}
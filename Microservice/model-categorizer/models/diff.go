@@ -0,0 +1,131 @@
+package models
+
+import (
+	"sort"
+	"strings"
+)
+
+// Field names reported in ModelChange.Fields
+const (
+	FieldContextSize  = "context_size"
+	FieldCapabilities = "capabilities"
+	FieldDeprecated   = "deprecated"
+)
+
+// ModelChange describes a model present in both catalogs whose tracked fields differ
+type ModelChange struct {
+	ID     string   `json:"id"`
+	Old    *Model   `json:"old"`
+	New    *Model   `json:"new"`
+	Fields []string `json:"fields"`
+}
+
+// CatalogDiff lists the differences between two classified catalogs
+type CatalogDiff struct {
+	Added   []*Model       `json:"added,omitempty"`
+	Removed []*Model       `json:"removed,omitempty"`
+	Changed []*ModelChange `json:"changed,omitempty"`
+}
+
+// IsEmpty reports whether the two catalogs were identical for the tracked fields
+func (d CatalogDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// CanonicalID returns the provider-qualified, case-insensitive key used to match models across catalogs
+func CanonicalID(model *Model) string {
+	id := strings.ToLower(model.ID)
+	if strings.Contains(id, "/") || model.Provider == "" {
+		return id
+	}
+	return strings.ToLower(model.Provider) + "/" + id
+}
+
+// DiffCatalogs compares two catalogs and returns added, removed and changed models,
+// sorted by canonical ID. Changes cover context size, capabilities and deprecation status.
+func DiffCatalogs(old, new []*Model) CatalogDiff {
+	oldByID := indexByCanonicalID(old)
+	newByID := indexByCanonicalID(new)
+
+	var diff CatalogDiff
+	for _, id := range sortedKeys(newByID) {
+		newModel := newByID[id]
+		oldModel, ok := oldByID[id]
+		if !ok {
+			diff.Added = append(diff.Added, newModel)
+			continue
+		}
+		if fields := changedFields(oldModel, newModel); len(fields) > 0 {
+			diff.Changed = append(diff.Changed, &ModelChange{
+				ID:     id,
+				Old:    oldModel,
+				New:    newModel,
+				Fields: fields,
+			})
+		}
+	}
+	for _, id := range sortedKeys(oldByID) {
+		if _, ok := newByID[id]; !ok {
+			diff.Removed = append(diff.Removed, oldByID[id])
+		}
+	}
+
+	return diff
+}
+
+// indexByCanonicalID maps models by canonical ID, keeping the last occurrence of duplicates
+func indexByCanonicalID(list []*Model) map[string]*Model {
+	index := make(map[string]*Model, len(list))
+	for _, model := range list {
+		if model != nil {
+			index[CanonicalID(model)] = model
+		}
+	}
+	return index
+}
+
+// sortedKeys returns the keys of a model index in sorted order
+func sortedKeys(index map[string]*Model) []string {
+	keys := make([]string, 0, len(index))
+	for key := range index {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// changedFields lists the tracked fields that differ between two versions of a model
+func changedFields(old, new *Model) []string {
+	var fields []string
+	if old.ContextSize != new.ContextSize {
+		fields = append(fields, FieldContextSize)
+	}
+	if !sameCapabilities(old.Capabilities, new.Capabilities) {
+		fields = append(fields, FieldCapabilities)
+	}
+	if isDeprecated(old) != isDeprecated(new) {
+		fields = append(fields, FieldDeprecated)
+	}
+	return fields
+}
+
+// sameCapabilities compares capability lists ignoring order and duplicates
+func sameCapabilities(a, b []string) bool {
+	set := make(map[string]bool, len(a))
+	for _, capability := range a {
+		set[capability] = true
+	}
+	other := make(map[string]bool, len(b))
+	for _, capability := range b {
+		if !set[capability] {
+			return false
+		}
+		other[capability] = true
+	}
+	return len(set) == len(other)
+}
+
+// isDeprecated reports whether a model is marked deprecated in its metadata
+func isDeprecated(model *Model) bool {
+	return model.Metadata["deprecated"] == "true"
+}
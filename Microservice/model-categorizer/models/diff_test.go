@@ -0,0 +1,97 @@
+package models
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDiffCatalogs(t *testing.T) {
+	old := []*Model{
+		{ID: "gpt-4o", Provider: "openai", ContextSize: 128000, Capabilities: []string{"chat", "vision"}},
+		{ID: "gpt-4-32k", Provider: "openai", ContextSize: 32768, Capabilities: []string{"chat"}},
+		{ID: "claude-3-opus", Provider: "anthropic", ContextSize: 200000, Capabilities: []string{"chat", "vision"}},
+		{ID: "gemini-1.5-pro", Provider: "google", ContextSize: 1000000, Capabilities: []string{"chat"}},
+		{ID: "gpt-3.5-turbo", Provider: "openai", ContextSize: 4096, Capabilities: []string{"chat"}},
+		{ID: "mistral-large", Provider: "mistral", ContextSize: 32000, Capabilities: []string{"chat", "function-calling"}},
+	}
+	new := []*Model{
+		// Unchanged apart from capability order and case of the ID
+		{ID: "GPT-4o", Provider: "openai", ContextSize: 128000, Capabilities: []string{"vision", "chat"}},
+		{ID: "claude-3-opus", Provider: "anthropic", ContextSize: 200000, Capabilities: []string{"chat", "vision"},
+			Metadata: map[string]string{"deprecated": "true"}},
+		{ID: "gemini-1.5-pro", Provider: "google", ContextSize: 2097152, Capabilities: []string{"chat", "vision"}},
+		{ID: "gpt-3.5-turbo", Provider: "openai", ContextSize: 16385, Capabilities: []string{"chat"}},
+		{ID: "mistral-large", Provider: "mistral", ContextSize: 32000, Capabilities: []string{"chat"}},
+		{ID: "o3-mini", Provider: "openai", ContextSize: 200000, Capabilities: []string{"chat"}},
+	}
+
+	diff := DiffCatalogs(old, new)
+
+	if len(diff.Added) != 1 || CanonicalID(diff.Added[0]) != "openai/o3-mini" {
+		t.Errorf("Added = %v, want [openai/o3-mini]", modelIDs(diff.Added))
+	}
+	if len(diff.Removed) != 1 || CanonicalID(diff.Removed[0]) != "openai/gpt-4-32k" {
+		t.Errorf("Removed = %v, want [openai/gpt-4-32k]", modelIDs(diff.Removed))
+	}
+
+	wantChanged := map[string][]string{
+		"anthropic/claude-3-opus": {FieldDeprecated},
+		"google/gemini-1.5-pro":   {FieldContextSize, FieldCapabilities},
+		"mistral/mistral-large":   {FieldCapabilities},
+		"openai/gpt-3.5-turbo":    {FieldContextSize},
+	}
+	if len(diff.Changed) != len(wantChanged) {
+		t.Fatalf("got %d changed models, want %d", len(diff.Changed), len(wantChanged))
+	}
+	for i, change := range diff.Changed {
+		want, ok := wantChanged[change.ID]
+		if !ok {
+			t.Errorf("unexpected change for %s: %v", change.ID, change.Fields)
+			continue
+		}
+		if !slices.Equal(change.Fields, want) {
+			t.Errorf("%s fields = %v, want %v", change.ID, change.Fields, want)
+		}
+		if change.Old == nil || change.New == nil {
+			t.Errorf("%s change is missing the old or new model", change.ID)
+		}
+		if i > 0 && diff.Changed[i-1].ID >= change.ID {
+			t.Errorf("changes not sorted by canonical ID: %s before %s", diff.Changed[i-1].ID, change.ID)
+		}
+	}
+}
+
+func TestDiffCatalogsIdentical(t *testing.T) {
+	catalog := []*Model{
+		{ID: "gpt-4o", Provider: "openai", ContextSize: 128000, Capabilities: []string{"chat", "vision"}},
+		{ID: "openrouter/meta-llama/llama-3-70b", ContextSize: 8192},
+	}
+	if diff := DiffCatalogs(catalog, catalog); !diff.IsEmpty() {
+		t.Errorf("diff of a catalog with itself = %+v, want empty", diff)
+	}
+}
+
+func TestCanonicalID(t *testing.T) {
+	tests := []struct {
+		model *Model
+		want  string
+	}{
+		{&Model{ID: "GPT-4o", Provider: "OpenAI"}, "openai/gpt-4o"},
+		{&Model{ID: "meta-llama/Llama-3-70b", Provider: "openrouter"}, "meta-llama/llama-3-70b"},
+		{&Model{ID: "custom-model"}, "custom-model"},
+	}
+
+	for _, tt := range tests {
+		if got := CanonicalID(tt.model); got != tt.want {
+			t.Errorf("CanonicalID(%+v) = %q, want %q", tt.model, got, tt.want)
+		}
+	}
+}
+
+func modelIDs(list []*Model) []string {
+	var ids []string
+	for _, model := range list {
+		ids = append(ids, CanonicalID(model))
+	}
+	return ids
+}
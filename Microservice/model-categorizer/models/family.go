@@ -0,0 +1,37 @@
+package models
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/chat-api/model-categorizer/classifiers"
+)
+
+// ModelsByFamily returns the models belonging to a family, newest first. The family is
+// matched case-insensitively against Family, FamilyDisplayName or Series, so both
+// "Claude" and "Claude 3" work. Models are ordered by their "release_date" metadata;
+// when either date is missing, by version number, then by name.
+func ModelsByFamily(models []*Model, family string) []*Model {
+	var result []*Model
+	for _, model := range models {
+		if strings.EqualFold(model.Family, family) ||
+			strings.EqualFold(model.FamilyDisplayName, family) ||
+			strings.EqualFold(model.Series, family) {
+			result = append(result, model)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		dateA, dateB := a.Metadata["release_date"], b.Metadata["release_date"]
+		if dateA != "" && dateB != "" && dateA != dateB {
+			return dateA > dateB
+		}
+		if a.Version != b.Version {
+			return classifiers.IsNewerVersion(a.Version, b.Version)
+		}
+		return a.ID < b.ID
+	})
+
+	return result
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// FineTuneJob is the subset of an OpenAI fine-tuning job object needed to classify its result
+type FineTuneJob struct {
+	ID             string `json:"id"`
+	Model          string `json:"model"`            // Base model the job trained from
+	FineTunedModel string `json:"fine_tuned_model"` // Resulting model ID; null until the job succeeds
+	Status         string `json:"status"`
+}
+
+// ParseFineTuneJob decodes an OpenAI fine-tuning job object and builds its Model
+func ParseFineTuneJob(data []byte) (*Model, error) {
+	var job FineTuneJob
+	if err := json.Unmarshal(data, &job); err != nil {
+		return nil, fmt.Errorf("decode fine-tune job: %w", err)
+	}
+	return ModelFromFineTuneJob(job)
+}
+
+// ModelFromFineTuneJob builds an unclassified Model for a job's fine-tuned model.
+// The model is flagged as fine-tuned with BaseModel set, so it is classified as its
+// base model rather than by parsing the "ft:..." ID.
+func ModelFromFineTuneJob(job FineTuneJob) (*Model, error) {
+	if job.Model == "" {
+		return nil, fmt.Errorf("fine-tune job %q has no base model", job.ID)
+	}
+	if job.FineTunedModel == "" {
+		return nil, fmt.Errorf("fine-tune job %q has no fine-tuned model yet (status %q)", job.ID, job.Status)
+	}
+
+	model := &Model{
+		ID:               job.FineTunedModel,
+		Name:             job.FineTunedModel,
+		Provider:         "openai",
+		OriginalProvider: "openai",
+		IsFineTuned:      true,
+		BaseModel:        job.Model,
+	}
+	if job.ID != "" {
+		model.Metadata = map[string]string{"fine_tune_job_id": job.ID}
+	}
+	return model, nil
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// GeminiModel is the subset of a Gemini API model (or tuned model) resource needed to classify it
+type GeminiModel struct {
+	Name                       string   `json:"name"` // "models/gemini-1.5-pro" or "tunedModels/my-model-x1y2"
+	BaseModel                  string   `json:"baseModel,omitempty"`
+	DisplayName                string   `json:"displayName,omitempty"`
+	Description                string   `json:"description,omitempty"`
+	InputTokenLimit            int32    `json:"inputTokenLimit,omitempty"`
+	OutputTokenLimit           int32    `json:"outputTokenLimit,omitempty"`
+	SupportedGenerationMethods []string `json:"supportedGenerationMethods,omitempty"`
+}
+
+// GeminiModelList is a Gemini models.list or tunedModels.list response page
+type GeminiModelList struct {
+	Models        []GeminiModel `json:"models"`
+	TunedModels   []GeminiModel `json:"tunedModels"`
+	NextPageToken string        `json:"nextPageToken,omitempty"`
+}
+
+// GeminiModelID strips the "models/" or "tunedModels/" resource prefix from a Gemini model name
+func GeminiModelID(name string) string {
+	if id, ok := strings.CutPrefix(name, "models/"); ok {
+		return id
+	}
+	return strings.TrimPrefix(name, "tunedModels/")
+}
+
+// ParseGeminiModelList decodes a Gemini list response and splits it with SplitGeminiModels
+func ParseGeminiModelList(data []byte) (chat, embedding []*Model, err error) {
+	var list GeminiModelList
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, nil, fmt.Errorf("decode Gemini model list: %w", err)
+	}
+	chat, embedding = SplitGeminiModels(append(list.Models, list.TunedModels...))
+	return chat, embedding, nil
+}
+
+// SplitGeminiModels builds unclassified Models from Gemini model resources. Models that
+// support generateContent are chat models; models that only support embedContent are
+// returned separately, marked with the embedding capability. Anything else (e.g.
+// AQA or image-only models) is dropped. Tuned models are classified as their base model.
+func SplitGeminiModels(geminiModels []GeminiModel) (chat, embedding []*Model) {
+	for _, geminiModel := range geminiModels {
+		generates := hasGenerationMethod(geminiModel, "generateContent")
+		embeds := hasGenerationMethod(geminiModel, "embedContent")
+		if !generates && !embeds {
+			continue
+		}
+
+		model := &Model{
+			ID:               GeminiModelID(geminiModel.Name),
+			Name:             GeminiModelID(geminiModel.Name),
+			Provider:         "google",
+			OriginalProvider: "gemini",
+			DisplayName:      geminiModel.DisplayName,
+			Description:      geminiModel.Description,
+			ContextSize:      geminiModel.InputTokenLimit,
+			MaxTokens:        geminiModel.OutputTokenLimit,
+		}
+		if geminiModel.BaseModel != "" {
+			model.IsFineTuned = true
+			model.BaseModel = GeminiModelID(geminiModel.BaseModel)
+		}
+
+		if generates {
+			chat = append(chat, model)
+		} else {
+			model.Capabilities = []string{"embedding"}
+			embedding = append(embedding, model)
+		}
+	}
+	return chat, embedding
+}
+
+// hasGenerationMethod reports whether a Gemini model supports the given API method
+func hasGenerationMethod(geminiModel GeminiModel, method string) bool {
+	for _, supported := range geminiModel.SupportedGenerationMethods {
+		if supported == method {
+			return true
+		}
+	}
+	return false
+}
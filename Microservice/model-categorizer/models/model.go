@@ -1,30 +1,63 @@
 package models
 
 import (
-	"sort"
+	"math"
+
+	"github.com/chat-api/model-categorizer/classifiers"
 )
 
 // Model represents a single LLM model
 type Model struct {
-	ID             string            `json:"id"`
-	Name           string            `json:"name,omitempty"`
-	ContextSize    int32             `json:"context_size,omitempty"`
-	MaxTokens      int32             `json:"max_tokens,omitempty"`
-	Provider       string            `json:"provider"`
-	OriginalProvider string          `json:"-"` // Store original provider but don't serialize
-	DisplayName    string            `json:"display_name,omitempty"`
-	Description    string            `json:"description,omitempty"`
-	CostPerToken   float64           `json:"cost_per_token,omitempty"`
-	Capabilities   []string          `json:"capabilities,omitempty"`
-	Family         string            `json:"family,omitempty"`
-	Type           string            `json:"type,omitempty"`
-	Series         string            `json:"series,omitempty"`
-	Variant        string            `json:"variant,omitempty"`
-	IsDefault      bool              `json:"is_default,omitempty"`
-	IsMultimodal   bool              `json:"is_multimodal,omitempty"`
-	IsExperimental bool              `json:"is_experimental,omitempty"`
-	Version        string            `json:"version,omitempty"`
-	Metadata       map[string]string `json:"metadata,omitempty"`
+	ID                   string            `json:"id"`
+	Name                 string            `json:"name,omitempty"`
+	ContextSize          int32             `json:"context_size,omitempty"`
+	MaxTokens            int32             `json:"max_tokens,omitempty"`
+	Provider             string            `json:"provider"`
+	OriginalProvider     string            `json:"-"` // Store original provider but don't serialize
+	DisplayName          string            `json:"display_name,omitempty"`
+	Description          string            `json:"description,omitempty"`
+	CostPerToken         float64           `json:"cost_per_token,omitempty"`
+	Capabilities         []string          `json:"capabilities,omitempty"`
+	ReasoningTiers       []string          `json:"reasoning_tiers,omitempty"` // Selectable reasoning effort levels; empty when not configurable
+	Family               string            `json:"family,omitempty"`
+	FamilyDisplayName    string            `json:"family_display_name,omitempty"`
+	Type                 string            `json:"type,omitempty"`
+	Series               string            `json:"series,omitempty"`
+	Variant              string            `json:"variant,omitempty"`
+	IsDefault            bool              `json:"is_default,omitempty"`
+	IsMultimodal         bool              `json:"is_multimodal,omitempty"`
+	IsExperimental       bool              `json:"is_experimental,omitempty"`
+	Version              string            `json:"version,omitempty"`
+	Quantization         string            `json:"quantization,omitempty"`
+	License              string            `json:"license,omitempty"`
+	IsOpenWeight         bool              `json:"is_open_weight,omitempty"`
+	Tuning               string            `json:"tuning,omitempty"`
+	InputModalities      []string          `json:"input_modalities,omitempty"`
+	OutputModalities     []string          `json:"output_modalities,omitempty"`
+	Tags                 []string          `json:"tags,omitempty"`
+	Confidence           float32           `json:"confidence,omitempty"`
+	IsDistilled          bool              `json:"is_distilled,omitempty"`
+	ContextCharsEstimate int32             `json:"context_chars_estimate,omitempty"`
+	RoutingVariant       string            `json:"routing_variant,omitempty"`
+	RawFamily            string            `json:"raw_family,omitempty"`
+	IsFineTuned          bool              `json:"is_fine_tuned,omitempty"`
+	BaseModel            string            `json:"base_model,omitempty"` // Classification source for fine-tuned models
+	Metadata             map[string]string `json:"metadata,omitempty"`
+}
+
+// charsPerToken is the rough average number of characters per token for English text
+const charsPerToken = 4
+
+// EstimateCharacters approximates how many characters fit in the given number of tokens
+func EstimateCharacters(tokens int32) int32 {
+	if tokens <= 0 {
+		return 0
+	}
+	// Saturate rather than overflow for very large windows
+	if tokens > math.MaxInt32/charsPerToken {
+		return math.MaxInt32
+	}
+	return tokens * charsPerToken
 }
 
 // LoadedModelList represents a list of models to be classified
@@ -32,6 +65,10 @@ type LoadedModelList struct {
 	Models          []*Model `json:"models"`
 	DefaultProvider string   `json:"default_provider,omitempty"`
 	DefaultModel    string   `json:"default_model,omitempty"`
+	RequestID       string   `json:"request_id,omitempty"`
+	KeepDuplicates  bool     `json:"keep_duplicates,omitempty"`
+	SkipEnhancement bool     `json:"skip_enhancement,omitempty"`
+	Fields          []string `json:"fields,omitempty"`
 }
 
 // ClassificationProperty represents a property by which models can be classified
@@ -51,11 +88,25 @@ type ClassifiedModelGroup struct {
 
 // ClassificationCriteria defines how models should be classified
 type ClassificationCriteria struct {
-	Properties          []string `json:"properties,omitempty"`
-	IncludeExperimental bool     `json:"include_experimental,omitempty"`
-	IncludeDeprecated   bool     `json:"include_deprecated,omitempty"`
-	MinContextSize      int32    `json:"min_context_size,omitempty"`
-	Hierarchical        bool     `json:"hierarchical,omitempty"`
+	Properties           []string `json:"properties,omitempty"`
+	IncludeExperimental  bool     `json:"include_experimental,omitempty"`
+	IncludeDeprecated    bool     `json:"include_deprecated,omitempty"`
+	MinContextSize       int32    `json:"min_context_size,omitempty"`
+	MaxContextSize       int32    `json:"max_context_size,omitempty"`
+	FilterByTags         []string `json:"filter_by_tags,omitempty"`
+	MatchAllTags         bool     `json:"match_all_tags,omitempty"`
+	BothViews            bool     `json:"both_views,omitempty"`
+	PreferDefaults       bool     `json:"prefer_defaults,omitempty"`
+	IncludeCharsEstimate bool     `json:"include_chars_estimate,omitempty"`
+	KeepDuplicates       bool     `json:"keep_duplicates,omitempty"`
+	MetadataGroupKey     string   `json:"metadata_group_key,omitempty"`
+	SkeletonOnly         bool     `json:"skeleton_only,omitempty"`
+	Format               string   `json:"format,omitempty"`
+	MultimodalOnly       bool     `json:"multimodal_only,omitempty"`
+	Fields               []string `json:"fields,omitempty"`
+	MaxHierarchyDepth    int32    `json:"max_hierarchy_depth,omitempty"`
+	Hierarchical         bool     `json:"hierarchical,omitempty"`
+	SortBy               string   `json:"sort_by,omitempty"`
 }
 
 // ClassifiedModelResponse represents the response from the classification server
@@ -71,17 +122,18 @@ func AvailableClassificationProperties() []*ClassificationProperty {
 		{
 			Name:        "provider",
 			DisplayName: "Provider",
-			Description: "The AI provider that offers the model",
+			Description: "The AI provider that offers the model; all Google models (Gemini, Gemma, Imagen) use \"google\"",
 			PossibleValues: []string{
-				"openai", "anthropic", "gemini", "meta", "mistral", "cohere", "openrouter", "other",
+				"openai", "anthropic", "google", "meta", "mistral", "cohere", "openrouter", "stability", "other",
 			},
 		},
 		{
 			Name:        "family",
 			DisplayName: "Model Family",
-			Description: "The family or generation that the model belongs to",
+			Description: "The brand family that the model belongs to, independent of its series or generation",
 			PossibleValues: []string{
-				"GPT-4", "GPT-3.5", "Claude 3", "Claude 2", "Gemini 1.5", "Gemini 1.0", "Llama", "Mistral",
+				"GPT", "GPT-OSS", "O Series", "DALL-E", "GPT Image", "Whisper", "TTS", "OpenAI Embedding", "Claude", "Gemini", "Gemma",
+				"Imagen", "Llama", "Mistral", "Stable Diffusion", "FLUX", "Qwen", "Phi", "DeepSeek", "Command",
 			},
 		},
 		{
@@ -89,7 +141,8 @@ func AvailableClassificationProperties() []*ClassificationProperty {
 			DisplayName: "Model Type",
 			Description: "The specific type or version of the model",
 			PossibleValues: []string{
-				"Vision", "Standard", "Pro", "Flash","Gemma", "Opus", "Sonnet", "Haiku", "Embedding", "O Series", "GPT 3.5", "GPT 4", "GPT 4.5", "Mini", "Flash Lite", "Thinking", "Image Generation",
+				"Vision", "Standard", "Pro", "Flash", "Gemma", "Opus", "Sonnet", "Haiku", "Embedding", "Reranker", "O Series", "GPT 3.5", "GPT 4", "GPT 4.5", "GPT OSS", "Mini", "Flash Lite", "Thinking", "Image Generation", "Speech", "Text-to-Speech", "Realtime",
+				"Large", "Medium", "Small", "Tiny", "Mixtral", "Codestral", "Ministral",
 			},
 		},
 		{
@@ -100,24 +153,79 @@ func AvailableClassificationProperties() []*ClassificationProperty {
 				"Small (< 10K)", "Medium (10K-100K)", "Large (100K-200K)", "Very Large (> 200K)",
 			},
 		},
+		{
+			Name:        "series",
+			DisplayName: "Series",
+			Description: "The model series within a provider's lineup",
+		},
+		{
+			Name:        "variant",
+			DisplayName: "Variant",
+			Description: "The specific version or variant of the model",
+		},
+		{
+			Name:           "multimodal",
+			DisplayName:    "Multimodal",
+			Description:    "Whether the model accepts non-text input",
+			PossibleValues: []string{"Yes", "No"},
+		},
+		{
+			Name:        "quantization",
+			DisplayName: "Quantization",
+			Description: "The quantization or precision format of open-weight models",
+			PossibleValues: []string{
+				"q4_K_M", "q5_K_M", "q8_0", "fp8", "fp16", "bf16", "int4", "int8", "awq", "gptq",
+			},
+		},
+		{
+			Name:           "license",
+			DisplayName:    "License",
+			Description:    "Whether the model weights are openly available or proprietary",
+			PossibleValues: []string{"open", "proprietary", "unknown"},
+		},
+		{
+			Name:           "tuning",
+			DisplayName:    "Tuning",
+			Description:    "Whether an open-weight model is a base, instruction-tuned or chat-tuned checkpoint",
+			PossibleValues: []string{"base", "instruct", "chat"},
+		},
+		{
+			Name:           "input_modality",
+			DisplayName:    "Input Modality",
+			Description:    "The kinds of input the model accepts",
+			PossibleValues: []string{"text", "image", "audio"},
+		},
+		{
+			Name:           "output_modality",
+			DisplayName:    "Output Modality",
+			Description:    "The kinds of output the model produces",
+			PossibleValues: []string{"text", "image", "audio", "embedding", "score"},
+		},
+		{
+			Name:           "distilled",
+			DisplayName:    "Distilled",
+			Description:    "Whether the model was distilled from a larger teacher model",
+			PossibleValues: []string{"Yes", "No"},
+		},
+		{
+			Name:        "tag",
+			DisplayName: "Tags",
+			Description: "Free-form labels attached by providers (e.g. roleplay, coding)",
+		},
+		{
+			Name:        "metadata:<key>",
+			DisplayName: "Custom Metadata",
+			Description: "Groups by a client-supplied metadata key (e.g. metadata:tier); models without the key are skipped",
+		},
 		{
 			Name:        "capability",
 			DisplayName: "Capabilities",
 			Description: "Special model capabilities",
-			PossibleValues: []string{
-				"vision", "function-calling", "embedding", "streaming", "chat", "audio",
-			},
+			// Generated from the classifier's vocabulary (already sorted) so it can't drift
+			PossibleValues: classifiers.KnownCapabilities(),
 		},
 	}
-	
-	// Sort capability possible values alphabetically
-	for _, prop := range properties {
-		if prop.Name == "capability" {
-			sort.Strings(prop.PossibleValues)
-			break
-		}
-	}
-	
+
 	return properties
 }
 
@@ -127,4 +235,4 @@ type HierarchicalModelGroup struct {
 	GroupValue string                    `json:"group_value"`
 	Models     []*Model                  `json:"models,omitempty"`
 	Children   []*HierarchicalModelGroup `json:"children,omitempty"`
-}
\ No newline at end of file
+}
@@ -23,25 +23,42 @@ const (
 
 // Model represents a single LLM model
 type Model struct {
-	state        protoimpl.MessageState `protogen:"open.v1"`
-	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ContextSize  int32                  `protobuf:"varint,3,opt,name=context_size,json=contextSize,proto3" json:"context_size,omitempty"`
-	MaxTokens    int32                  `protobuf:"varint,4,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
-	Provider     string                 `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider,omitempty"`
-	DisplayName  string                 `protobuf:"bytes,6,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	Description  string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	CostPerToken float64                `protobuf:"fixed64,8,opt,name=cost_per_token,json=costPerToken,proto3" json:"cost_per_token,omitempty"`
-	Capabilities []string               `protobuf:"bytes,9,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ContextSize    int32                  `protobuf:"varint,3,opt,name=context_size,json=contextSize,proto3" json:"context_size,omitempty"`
+	MaxTokens      int32                  `protobuf:"varint,4,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	Provider       string                 `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider,omitempty"`
+	DisplayName    string                 `protobuf:"bytes,6,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Description    string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	CostPerToken   float64                `protobuf:"fixed64,8,opt,name=cost_per_token,json=costPerToken,proto3" json:"cost_per_token,omitempty"`
+	Capabilities   []string               `protobuf:"bytes,9,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	ReasoningTiers []string               `protobuf:"bytes,35,rep,name=reasoning_tiers,json=reasoningTiers,proto3" json:"reasoning_tiers,omitempty"` // Selectable reasoning effort levels (e.g. "low", "medium", "high"); empty when not configurable
 	// Classification fields
-	Family         string `protobuf:"bytes,10,opt,name=family,proto3" json:"family,omitempty"`
-	Type           string `protobuf:"bytes,11,opt,name=type,proto3" json:"type,omitempty"`
-	Series         string `protobuf:"bytes,12,opt,name=series,proto3" json:"series,omitempty"`
-	Variant        string `protobuf:"bytes,13,opt,name=variant,proto3" json:"variant,omitempty"`
-	IsDefault      bool   `protobuf:"varint,14,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
-	IsMultimodal   bool   `protobuf:"varint,15,opt,name=is_multimodal,json=isMultimodal,proto3" json:"is_multimodal,omitempty"`
-	IsExperimental bool   `protobuf:"varint,16,opt,name=is_experimental,json=isExperimental,proto3" json:"is_experimental,omitempty"`
-	Version        string `protobuf:"bytes,17,opt,name=version,proto3" json:"version,omitempty"`
+	Family               string   `protobuf:"bytes,10,opt,name=family,proto3" json:"family,omitempty"`
+	Type                 string   `protobuf:"bytes,11,opt,name=type,proto3" json:"type,omitempty"`
+	Series               string   `protobuf:"bytes,12,opt,name=series,proto3" json:"series,omitempty"`
+	Variant              string   `protobuf:"bytes,13,opt,name=variant,proto3" json:"variant,omitempty"`
+	IsDefault            bool     `protobuf:"varint,14,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	IsMultimodal         bool     `protobuf:"varint,15,opt,name=is_multimodal,json=isMultimodal,proto3" json:"is_multimodal,omitempty"`
+	IsExperimental       bool     `protobuf:"varint,16,opt,name=is_experimental,json=isExperimental,proto3" json:"is_experimental,omitempty"`
+	Version              string   `protobuf:"bytes,17,opt,name=version,proto3" json:"version,omitempty"`
+	Quantization         string   `protobuf:"bytes,18,opt,name=quantization,proto3" json:"quantization,omitempty"`                                 // Quantization/precision suffix (e.g. "q4_K_M", "fp8")
+	OriginalProvider     string   `protobuf:"bytes,19,opt,name=original_provider,json=originalProvider,proto3" json:"original_provider,omitempty"` // Provider as sent by the client (e.g. "openrouter" for aggregated models)
+	License              string   `protobuf:"bytes,21,opt,name=license,proto3" json:"license,omitempty"`                                           // "open", "proprietary" or "unknown"
+	IsOpenWeight         bool     `protobuf:"varint,22,opt,name=is_open_weight,json=isOpenWeight,proto3" json:"is_open_weight,omitempty"`
+	Tuning               string   `protobuf:"bytes,23,opt,name=tuning,proto3" json:"tuning,omitempty"`                                                            // "base", "instruct" or "chat" for open-weight checkpoints
+	FamilyDisplayName    string   `protobuf:"bytes,24,opt,name=family_display_name,json=familyDisplayName,proto3" json:"family_display_name,omitempty"`           // Display label for family (configurable, defaults to family)
+	InputModalities      []string `protobuf:"bytes,25,rep,name=input_modalities,json=inputModalities,proto3" json:"input_modalities,omitempty"`                   // What the model accepts: "text", "image", "audio"
+	OutputModalities     []string `protobuf:"bytes,26,rep,name=output_modalities,json=outputModalities,proto3" json:"output_modalities,omitempty"`                // What the model produces: "text", "image", "audio", "embedding", "score" (rerankers)
+	Tags                 []string `protobuf:"bytes,27,rep,name=tags,proto3" json:"tags,omitempty"`                                                                // Provider tags (e.g. "roleplay", "coding"); also read from metadata["tags"]
+	Confidence           float32  `protobuf:"fixed32,28,opt,name=confidence,proto3" json:"confidence,omitempty"`                                                  // 0-1 trust in the classification (1 = registry match, low = "other" fallback)
+	IsDistilled          bool     `protobuf:"varint,29,opt,name=is_distilled,json=isDistilled,proto3" json:"is_distilled,omitempty"`                              // Distilled from a teacher model; the teacher is in metadata["distilled_from"]
+	ContextCharsEstimate int32    `protobuf:"varint,30,opt,name=context_chars_estimate,json=contextCharsEstimate,proto3" json:"context_chars_estimate,omitempty"` // Approximate characters fitting in context_size (~4 chars/token); set when requested
+	RoutingVariant       string   `protobuf:"bytes,31,opt,name=routing_variant,json=routingVariant,proto3" json:"routing_variant,omitempty"`                      // OpenRouter routing suffix (e.g. "free", "nitro"); the model is classified without it
+	RawFamily            string   `protobuf:"bytes,32,opt,name=raw_family,json=rawFamily,proto3" json:"raw_family,omitempty"`                                     // Name token that matched the family (e.g. "gpt" for family "GPT"); empty when none matched
+	IsFineTuned          bool     `protobuf:"varint,33,opt,name=is_fine_tuned,json=isFineTuned,proto3" json:"is_fine_tuned,omitempty"`                            // Set automatically when base_model is given
+	BaseModel            string   `protobuf:"bytes,34,opt,name=base_model,json=baseModel,proto3" json:"base_model,omitempty"`                                     // Model a fine-tune was trained from; when set, the model is classified as this base
 	// Additional metadata as string key-value pairs
 	Metadata      map[string]string `protobuf:"bytes,20,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
@@ -141,6 +158,13 @@ func (x *Model) GetCapabilities() []string {
 	return nil
 }
 
+func (x *Model) GetReasoningTiers() []string {
+	if x != nil {
+		return x.ReasoningTiers
+	}
+	return nil
+}
+
 func (x *Model) GetFamily() string {
 	if x != nil {
 		return x.Family
@@ -197,6 +221,118 @@ func (x *Model) GetVersion() string {
 	return ""
 }
 
+func (x *Model) GetQuantization() string {
+	if x != nil {
+		return x.Quantization
+	}
+	return ""
+}
+
+func (x *Model) GetOriginalProvider() string {
+	if x != nil {
+		return x.OriginalProvider
+	}
+	return ""
+}
+
+func (x *Model) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+func (x *Model) GetIsOpenWeight() bool {
+	if x != nil {
+		return x.IsOpenWeight
+	}
+	return false
+}
+
+func (x *Model) GetTuning() string {
+	if x != nil {
+		return x.Tuning
+	}
+	return ""
+}
+
+func (x *Model) GetFamilyDisplayName() string {
+	if x != nil {
+		return x.FamilyDisplayName
+	}
+	return ""
+}
+
+func (x *Model) GetInputModalities() []string {
+	if x != nil {
+		return x.InputModalities
+	}
+	return nil
+}
+
+func (x *Model) GetOutputModalities() []string {
+	if x != nil {
+		return x.OutputModalities
+	}
+	return nil
+}
+
+func (x *Model) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Model) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *Model) GetIsDistilled() bool {
+	if x != nil {
+		return x.IsDistilled
+	}
+	return false
+}
+
+func (x *Model) GetContextCharsEstimate() int32 {
+	if x != nil {
+		return x.ContextCharsEstimate
+	}
+	return 0
+}
+
+func (x *Model) GetRoutingVariant() string {
+	if x != nil {
+		return x.RoutingVariant
+	}
+	return ""
+}
+
+func (x *Model) GetRawFamily() string {
+	if x != nil {
+		return x.RawFamily
+	}
+	return ""
+}
+
+func (x *Model) GetIsFineTuned() bool {
+	if x != nil {
+		return x.IsFineTuned
+	}
+	return false
+}
+
+func (x *Model) GetBaseModel() string {
+	if x != nil {
+		return x.BaseModel
+	}
+	return ""
+}
+
 func (x *Model) GetMetadata() map[string]string {
 	if x != nil {
 		return x.Metadata
@@ -210,6 +346,10 @@ type LoadedModelList struct {
 	Models          []*Model               `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
 	DefaultProvider string                 `protobuf:"bytes,2,opt,name=default_provider,json=defaultProvider,proto3" json:"default_provider,omitempty"`
 	DefaultModel    string                 `protobuf:"bytes,3,opt,name=default_model,json=defaultModel,proto3" json:"default_model,omitempty"`
+	RequestId       string                 `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`                    // Optional client-chosen ID; retries with the same ID get the original response
+	KeepDuplicates  bool                   `protobuf:"varint,5,opt,name=keep_duplicates,json=keepDuplicates,proto3" json:"keep_duplicates,omitempty"`    // Keep repeated model IDs instead of collapsing them to the first occurrence
+	SkipEnhancement bool                   `protobuf:"varint,6,opt,name=skip_enhancement,json=skipEnhancement,proto3" json:"skip_enhancement,omitempty"` // Models are pre-classified: use their family/type/capabilities verbatim
+	Fields          []string               `protobuf:"bytes,7,rep,name=fields,proto3" json:"fields,omitempty"`                                           // Model fields to return (proto names, e.g. "id", "provider"); empty returns all
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -265,6 +405,34 @@ func (x *LoadedModelList) GetDefaultModel() string {
 	return ""
 }
 
+func (x *LoadedModelList) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *LoadedModelList) GetKeepDuplicates() bool {
+	if x != nil {
+		return x.KeepDuplicates
+	}
+	return false
+}
+
+func (x *LoadedModelList) GetSkipEnhancement() bool {
+	if x != nil {
+		return x.SkipEnhancement
+	}
+	return false
+}
+
+func (x *LoadedModelList) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
 // ClassificationProperty represents a property by which models can be classified
 type ClassificationProperty struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -397,14 +565,30 @@ func (x *ClassifiedModelGroup) GetModels() []*Model {
 
 // ClassificationCriteria defines how models should be classified
 type ClassificationCriteria struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Properties          []string               `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties,omitempty"`
-	IncludeExperimental bool                   `protobuf:"varint,2,opt,name=include_experimental,json=includeExperimental,proto3" json:"include_experimental,omitempty"`
-	IncludeDeprecated   bool                   `protobuf:"varint,3,opt,name=include_deprecated,json=includeDeprecated,proto3" json:"include_deprecated,omitempty"`
-	MinContextSize      int32                  `protobuf:"varint,4,opt,name=min_context_size,json=minContextSize,proto3" json:"min_context_size,omitempty"`
-	Hierarchical        bool                   `protobuf:"varint,5,opt,name=hierarchical,proto3" json:"hierarchical,omitempty"` // When true, returns hierarchical structure instead of flat groups
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Properties           []string               `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties,omitempty"` // Flat grouping properties; also the hierarchy levels when hierarchy_dimensions is empty
+	IncludeExperimental  bool                   `protobuf:"varint,2,opt,name=include_experimental,json=includeExperimental,proto3" json:"include_experimental,omitempty"`
+	IncludeDeprecated    bool                   `protobuf:"varint,3,opt,name=include_deprecated,json=includeDeprecated,proto3" json:"include_deprecated,omitempty"`
+	MinContextSize       int32                  `protobuf:"varint,4,opt,name=min_context_size,json=minContextSize,proto3" json:"min_context_size,omitempty"`                    // Inclusive lower bound on context size (0 = no limit)
+	Hierarchical         bool                   `protobuf:"varint,5,opt,name=hierarchical,proto3" json:"hierarchical,omitempty"`                                                // When true, returns hierarchical structure instead of flat groups
+	ProviderGrouping     string                 `protobuf:"bytes,6,opt,name=provider_grouping,json=providerGrouping,proto3" json:"provider_grouping,omitempty"`                 // "original" groups by the aggregator, "resolved" by the resolved sub-provider
+	HierarchyDimensions  []string               `protobuf:"bytes,7,rep,name=hierarchy_dimensions,json=hierarchyDimensions,proto3" json:"hierarchy_dimensions,omitempty"`        // Hierarchy levels in order (default: properties, else provider, type, variant); "capability" fans out
+	SortBy               string                 `protobuf:"bytes,8,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`                                               // Flat results only: "context_desc", "context_asc", "name" or "release_date"
+	MaxContextSize       int32                  `protobuf:"varint,9,opt,name=max_context_size,json=maxContextSize,proto3" json:"max_context_size,omitempty"`                    // Inclusive upper bound on context size (0 = no limit)
+	FilterByTags         []string               `protobuf:"bytes,10,rep,name=filter_by_tags,json=filterByTags,proto3" json:"filter_by_tags,omitempty"`                          // Keep only models carrying these tags
+	MatchAllTags         bool                   `protobuf:"varint,11,opt,name=match_all_tags,json=matchAllTags,proto3" json:"match_all_tags,omitempty"`                         // Require every tag in filter_by_tags instead of any one
+	BothViews            bool                   `protobuf:"varint,12,opt,name=both_views,json=bothViews,proto3" json:"both_views,omitempty"`                                    // Return both hierarchical_groups and classified_groups in one response
+	PreferDefaults       bool                   `protobuf:"varint,13,opt,name=prefer_defaults,json=preferDefaults,proto3" json:"prefer_defaults,omitempty"`                     // Hierarchical ordering: put is_default models first within the same provider and type
+	IncludeCharsEstimate bool                   `protobuf:"varint,14,opt,name=include_chars_estimate,json=includeCharsEstimate,proto3" json:"include_chars_estimate,omitempty"` // Fill context_chars_estimate on each model
+	KeepDuplicates       bool                   `protobuf:"varint,15,opt,name=keep_duplicates,json=keepDuplicates,proto3" json:"keep_duplicates,omitempty"`                     // Keep repeated model IDs instead of collapsing them to the first occurrence
+	MetadataGroupKey     string                 `protobuf:"bytes,16,opt,name=metadata_group_key,json=metadataGroupKey,proto3" json:"metadata_group_key,omitempty"`              // Also group by this Model.metadata key (property "metadata:<key>"); models without it are skipped
+	SkeletonOnly         bool                   `protobuf:"varint,17,opt,name=skeleton_only,json=skeletonOnly,proto3" json:"skeleton_only,omitempty"`                           // Return the hierarchy's groups and model_count without any models (implies hierarchical)
+	Format               string                 `protobuf:"bytes,18,opt,name=format,proto3" json:"format,omitempty"`                                                            // Hierarchy encoding: "groups" (default) fills hierarchical_groups, "map" fills tree_json instead
+	MultimodalOnly       bool                   `protobuf:"varint,19,opt,name=multimodal_only,json=multimodalOnly,proto3" json:"multimodal_only,omitempty"`                     // Keep only models classified as multimodal
+	Fields               []string               `protobuf:"bytes,20,rep,name=fields,proto3" json:"fields,omitempty"`                                                            // Model fields to return in groups (proto names, e.g. "id", "provider"); empty returns all. tree_json is not masked
+	MaxHierarchyDepth    int32                  `protobuf:"varint,21,opt,name=max_hierarchy_depth,json=maxHierarchyDepth,proto3" json:"max_hierarchy_depth,omitempty"`          // Cap on hierarchy levels (0 = server default of 4); can lower but not raise the server's cap
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *ClassificationCriteria) Reset() {
@@ -472,6 +656,118 @@ func (x *ClassificationCriteria) GetHierarchical() bool {
 	return false
 }
 
+func (x *ClassificationCriteria) GetProviderGrouping() string {
+	if x != nil {
+		return x.ProviderGrouping
+	}
+	return ""
+}
+
+func (x *ClassificationCriteria) GetHierarchyDimensions() []string {
+	if x != nil {
+		return x.HierarchyDimensions
+	}
+	return nil
+}
+
+func (x *ClassificationCriteria) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ClassificationCriteria) GetMaxContextSize() int32 {
+	if x != nil {
+		return x.MaxContextSize
+	}
+	return 0
+}
+
+func (x *ClassificationCriteria) GetFilterByTags() []string {
+	if x != nil {
+		return x.FilterByTags
+	}
+	return nil
+}
+
+func (x *ClassificationCriteria) GetMatchAllTags() bool {
+	if x != nil {
+		return x.MatchAllTags
+	}
+	return false
+}
+
+func (x *ClassificationCriteria) GetBothViews() bool {
+	if x != nil {
+		return x.BothViews
+	}
+	return false
+}
+
+func (x *ClassificationCriteria) GetPreferDefaults() bool {
+	if x != nil {
+		return x.PreferDefaults
+	}
+	return false
+}
+
+func (x *ClassificationCriteria) GetIncludeCharsEstimate() bool {
+	if x != nil {
+		return x.IncludeCharsEstimate
+	}
+	return false
+}
+
+func (x *ClassificationCriteria) GetKeepDuplicates() bool {
+	if x != nil {
+		return x.KeepDuplicates
+	}
+	return false
+}
+
+func (x *ClassificationCriteria) GetMetadataGroupKey() string {
+	if x != nil {
+		return x.MetadataGroupKey
+	}
+	return ""
+}
+
+func (x *ClassificationCriteria) GetSkeletonOnly() bool {
+	if x != nil {
+		return x.SkeletonOnly
+	}
+	return false
+}
+
+func (x *ClassificationCriteria) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ClassificationCriteria) GetMultimodalOnly() bool {
+	if x != nil {
+		return x.MultimodalOnly
+	}
+	return false
+}
+
+func (x *ClassificationCriteria) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ClassificationCriteria) GetMaxHierarchyDepth() int32 {
+	if x != nil {
+		return x.MaxHierarchyDepth
+	}
+	return 0
+}
+
 // ClassifiedModelResponse represents the response from the classification server
 type ClassifiedModelResponse struct {
 	state               protoimpl.MessageState    `protogen:"open.v1"`
@@ -479,6 +775,9 @@ type ClassifiedModelResponse struct {
 	AvailableProperties []*ClassificationProperty `protobuf:"bytes,2,rep,name=available_properties,json=availableProperties,proto3" json:"available_properties,omitempty"`
 	ErrorMessage        string                    `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	HierarchicalGroups  []*HierarchicalModelGroup `protobuf:"bytes,4,rep,name=hierarchical_groups,json=hierarchicalGroups,proto3" json:"hierarchical_groups,omitempty"` // Populated when hierarchical=true in request
+	Summary             *ClassificationSummary    `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+	TreeJson            string                    `protobuf:"bytes,6,opt,name=tree_json,json=treeJson,proto3" json:"tree_json,omitempty"` // format=map: {provider: {type: {variant: [models]}}}; groups with children keep own models under "_models"
+	Warnings            []string                  `protobuf:"bytes,7,rep,name=warnings,proto3" json:"warnings,omitempty"`                 // Non-fatal adjustments to the request, e.g. hierarchy dimensions dropped by the depth cap
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -541,31 +840,57 @@ func (x *ClassifiedModelResponse) GetHierarchicalGroups() []*HierarchicalModelGr
 	return nil
 }
 
-// HierarchicalModelGroup represents a hierarchical grouping of models
-type HierarchicalModelGroup struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	GroupName     string                    `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
-	GroupValue    string                    `protobuf:"bytes,2,opt,name=group_value,json=groupValue,proto3" json:"group_value,omitempty"`
-	Models        []*Model                  `protobuf:"bytes,3,rep,name=models,proto3" json:"models,omitempty"`
-	Children      []*HierarchicalModelGroup `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ClassifiedModelResponse) GetSummary() *ClassificationSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
 }
 
-func (x *HierarchicalModelGroup) Reset() {
-	*x = HierarchicalModelGroup{}
+func (x *ClassifiedModelResponse) GetTreeJson() string {
+	if x != nil {
+		return x.TreeJson
+	}
+	return ""
+}
+
+func (x *ClassifiedModelResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// ClassificationSummary contains aggregate statistics for the classified models
+type ClassificationSummary struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TotalModels       int32                  `protobuf:"varint,1,opt,name=total_models,json=totalModels,proto3" json:"total_models,omitempty"`
+	ProviderCounts    map[string]int32       `protobuf:"bytes,2,rep,name=provider_counts,json=providerCounts,proto3" json:"provider_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	TypeCounts        map[string]int32       `protobuf:"bytes,3,rep,name=type_counts,json=typeCounts,proto3" json:"type_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	CapabilityCounts  map[string]int32       `protobuf:"bytes,4,rep,name=capability_counts,json=capabilityCounts,proto3" json:"capability_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	MultimodalCount   int32                  `protobuf:"varint,5,opt,name=multimodal_count,json=multimodalCount,proto3" json:"multimodal_count,omitempty"`
+	ExperimentalCount int32                  `protobuf:"varint,6,opt,name=experimental_count,json=experimentalCount,proto3" json:"experimental_count,omitempty"`
+	MinContextSize    int32                  `protobuf:"varint,7,opt,name=min_context_size,json=minContextSize,proto3" json:"min_context_size,omitempty"`
+	MaxContextSize    int32                  `protobuf:"varint,8,opt,name=max_context_size,json=maxContextSize,proto3" json:"max_context_size,omitempty"`
+	AvgContextSize    float64                `protobuf:"fixed64,9,opt,name=avg_context_size,json=avgContextSize,proto3" json:"avg_context_size,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ClassificationSummary) Reset() {
+	*x = ClassificationSummary{}
 	mi := &file_models_proto_models_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HierarchicalModelGroup) String() string {
+func (x *ClassificationSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HierarchicalModelGroup) ProtoMessage() {}
+func (*ClassificationSummary) ProtoMessage() {}
 
-func (x *HierarchicalModelGroup) ProtoReflect() protoreflect.Message {
+func (x *ClassificationSummary) ProtoReflect() protoreflect.Message {
 	mi := &file_models_proto_models_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -577,73 +902,1626 @@ func (x *HierarchicalModelGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HierarchicalModelGroup.ProtoReflect.Descriptor instead.
-func (*HierarchicalModelGroup) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClassificationSummary.ProtoReflect.Descriptor instead.
+func (*ClassificationSummary) Descriptor() ([]byte, []int) {
 	return file_models_proto_models_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *HierarchicalModelGroup) GetGroupName() string {
+func (x *ClassificationSummary) GetTotalModels() int32 {
 	if x != nil {
-		return x.GroupName
+		return x.TotalModels
 	}
-	return ""
+	return 0
 }
 
-func (x *HierarchicalModelGroup) GetGroupValue() string {
+func (x *ClassificationSummary) GetProviderCounts() map[string]int32 {
 	if x != nil {
-		return x.GroupValue
+		return x.ProviderCounts
 	}
-	return ""
+	return nil
 }
 
-func (x *HierarchicalModelGroup) GetModels() []*Model {
+func (x *ClassificationSummary) GetTypeCounts() map[string]int32 {
 	if x != nil {
-		return x.Models
+		return x.TypeCounts
 	}
 	return nil
 }
 
-func (x *HierarchicalModelGroup) GetChildren() []*HierarchicalModelGroup {
+func (x *ClassificationSummary) GetCapabilityCounts() map[string]int32 {
 	if x != nil {
-		return x.Children
+		return x.CapabilityCounts
 	}
 	return nil
 }
 
-var File_models_proto_models_proto protoreflect.FileDescriptor
+func (x *ClassificationSummary) GetMultimodalCount() int32 {
+	if x != nil {
+		return x.MultimodalCount
+	}
+	return 0
+}
 
-const file_models_proto_models_proto_rawDesc = "" +
-	"\n" +
-	"\x19models/proto/models.proto\x12\fmodelservice\"\xf9\x04\n" +
-	"\x05Model\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
-	"\fcontext_size\x18\x03 \x01(\x05R\vcontextSize\x12\x1d\n" +
-	"\n" +
-	"max_tokens\x18\x04 \x01(\x05R\tmaxTokens\x12\x1a\n" +
-	"\bprovider\x18\x05 \x01(\tR\bprovider\x12!\n" +
-	"\fdisplay_name\x18\x06 \x01(\tR\vdisplayName\x12 \n" +
-	"\vdescription\x18\a \x01(\tR\vdescription\x12$\n" +
-	"\x0ecost_per_token\x18\b \x01(\x01R\fcostPerToken\x12\"\n" +
-	"\fcapabilities\x18\t \x03(\tR\fcapabilities\x12\x16\n" +
-	"\x06family\x18\n" +
-	" \x01(\tR\x06family\x12\x12\n" +
-	"\x04type\x18\v \x01(\tR\x04type\x12\x16\n" +
-	"\x06series\x18\f \x01(\tR\x06series\x12\x18\n" +
-	"\avariant\x18\r \x01(\tR\avariant\x12\x1d\n" +
-	"\n" +
-	"is_default\x18\x0e \x01(\bR\tisDefault\x12#\n" +
-	"\ris_multimodal\x18\x0f \x01(\bR\fisMultimodal\x12'\n" +
-	"\x0fis_experimental\x18\x10 \x01(\bR\x0eisExperimental\x12\x18\n" +
-	"\aversion\x18\x11 \x01(\tR\aversion\x12=\n" +
-	"\bmetadata\x18\x14 \x03(\v2!.modelservice.Model.MetadataEntryR\bmetadata\x1a;\n" +
+func (x *ClassificationSummary) GetExperimentalCount() int32 {
+	if x != nil {
+		return x.ExperimentalCount
+	}
+	return 0
+}
+
+func (x *ClassificationSummary) GetMinContextSize() int32 {
+	if x != nil {
+		return x.MinContextSize
+	}
+	return 0
+}
+
+func (x *ClassificationSummary) GetMaxContextSize() int32 {
+	if x != nil {
+		return x.MaxContextSize
+	}
+	return 0
+}
+
+func (x *ClassificationSummary) GetAvgContextSize() float64 {
+	if x != nil {
+		return x.AvgContextSize
+	}
+	return 0
+}
+
+// HierarchicalModelGroup represents a hierarchical grouping of models
+type HierarchicalModelGroup struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	GroupName     string                    `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	GroupValue    string                    `protobuf:"bytes,2,opt,name=group_value,json=groupValue,proto3" json:"group_value,omitempty"`
+	Models        []*Model                  `protobuf:"bytes,3,rep,name=models,proto3" json:"models,omitempty"`
+	Children      []*HierarchicalModelGroup `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty"`
+	ModelCount    int32                     `protobuf:"varint,5,opt,name=model_count,json=modelCount,proto3" json:"model_count,omitempty"` // Models in this group and all its children (fanned-out models count once per group)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HierarchicalModelGroup) Reset() {
+	*x = HierarchicalModelGroup{}
+	mi := &file_models_proto_models_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HierarchicalModelGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HierarchicalModelGroup) ProtoMessage() {}
+
+func (x *HierarchicalModelGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HierarchicalModelGroup.ProtoReflect.Descriptor instead.
+func (*HierarchicalModelGroup) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HierarchicalModelGroup) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *HierarchicalModelGroup) GetGroupValue() string {
+	if x != nil {
+		return x.GroupValue
+	}
+	return ""
+}
+
+func (x *HierarchicalModelGroup) GetModels() []*Model {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+func (x *HierarchicalModelGroup) GetChildren() []*HierarchicalModelGroup {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *HierarchicalModelGroup) GetModelCount() int32 {
+	if x != nil {
+		return x.ModelCount
+	}
+	return 0
+}
+
+// ModelMetadata represents the flat classification metadata for a single model
+type ModelMetadata struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Provider         string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Series           string                 `protobuf:"bytes,3,opt,name=series,proto3" json:"series,omitempty"`
+	Type             string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Variant          string                 `protobuf:"bytes,5,opt,name=variant,proto3" json:"variant,omitempty"`
+	ContextSize      int32                  `protobuf:"varint,6,opt,name=context_size,json=contextSize,proto3" json:"context_size,omitempty"`
+	Capabilities     []string               `protobuf:"bytes,7,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	IsMultimodal     bool                   `protobuf:"varint,8,opt,name=is_multimodal,json=isMultimodal,proto3" json:"is_multimodal,omitempty"`
+	IsExperimental   bool                   `protobuf:"varint,9,opt,name=is_experimental,json=isExperimental,proto3" json:"is_experimental,omitempty"`
+	DisplayName      string                 `protobuf:"bytes,10,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Quantization     string                 `protobuf:"bytes,11,opt,name=quantization,proto3" json:"quantization,omitempty"`
+	License          string                 `protobuf:"bytes,12,opt,name=license,proto3" json:"license,omitempty"`
+	IsOpenWeight     bool                   `protobuf:"varint,13,opt,name=is_open_weight,json=isOpenWeight,proto3" json:"is_open_weight,omitempty"`
+	Family           string                 `protobuf:"bytes,14,opt,name=family,proto3" json:"family,omitempty"`
+	Tuning           string                 `protobuf:"bytes,15,opt,name=tuning,proto3" json:"tuning,omitempty"`
+	InputModalities  []string               `protobuf:"bytes,16,rep,name=input_modalities,json=inputModalities,proto3" json:"input_modalities,omitempty"`
+	OutputModalities []string               `protobuf:"bytes,17,rep,name=output_modalities,json=outputModalities,proto3" json:"output_modalities,omitempty"`
+	Confidence       float32                `protobuf:"fixed32,18,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	IsDistilled      bool                   `protobuf:"varint,19,opt,name=is_distilled,json=isDistilled,proto3" json:"is_distilled,omitempty"`
+	DistilledFrom    string                 `protobuf:"bytes,20,opt,name=distilled_from,json=distilledFrom,proto3" json:"distilled_from,omitempty"` // Teacher model for distilled models (e.g. "deepseek-r1")
+	RoutingVariant   string                 `protobuf:"bytes,21,opt,name=routing_variant,json=routingVariant,proto3" json:"routing_variant,omitempty"`
+	RawFamily        string                 `protobuf:"bytes,22,opt,name=raw_family,json=rawFamily,proto3" json:"raw_family,omitempty"` // Name token that matched the family, before normalization
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ModelMetadata) Reset() {
+	*x = ModelMetadata{}
+	mi := &file_models_proto_models_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelMetadata) ProtoMessage() {}
+
+func (x *ModelMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelMetadata.ProtoReflect.Descriptor instead.
+func (*ModelMetadata) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ModelMetadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModelMetadata) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ModelMetadata) GetSeries() string {
+	if x != nil {
+		return x.Series
+	}
+	return ""
+}
+
+func (x *ModelMetadata) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ModelMetadata) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *ModelMetadata) GetContextSize() int32 {
+	if x != nil {
+		return x.ContextSize
+	}
+	return 0
+}
+
+func (x *ModelMetadata) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *ModelMetadata) GetIsMultimodal() bool {
+	if x != nil {
+		return x.IsMultimodal
+	}
+	return false
+}
+
+func (x *ModelMetadata) GetIsExperimental() bool {
+	if x != nil {
+		return x.IsExperimental
+	}
+	return false
+}
+
+func (x *ModelMetadata) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *ModelMetadata) GetQuantization() string {
+	if x != nil {
+		return x.Quantization
+	}
+	return ""
+}
+
+func (x *ModelMetadata) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+func (x *ModelMetadata) GetIsOpenWeight() bool {
+	if x != nil {
+		return x.IsOpenWeight
+	}
+	return false
+}
+
+func (x *ModelMetadata) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+func (x *ModelMetadata) GetTuning() string {
+	if x != nil {
+		return x.Tuning
+	}
+	return ""
+}
+
+func (x *ModelMetadata) GetInputModalities() []string {
+	if x != nil {
+		return x.InputModalities
+	}
+	return nil
+}
+
+func (x *ModelMetadata) GetOutputModalities() []string {
+	if x != nil {
+		return x.OutputModalities
+	}
+	return nil
+}
+
+func (x *ModelMetadata) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *ModelMetadata) GetIsDistilled() bool {
+	if x != nil {
+		return x.IsDistilled
+	}
+	return false
+}
+
+func (x *ModelMetadata) GetDistilledFrom() string {
+	if x != nil {
+		return x.DistilledFrom
+	}
+	return ""
+}
+
+func (x *ModelMetadata) GetRoutingVariant() string {
+	if x != nil {
+		return x.RoutingVariant
+	}
+	return ""
+}
+
+func (x *ModelMetadata) GetRawFamily() string {
+	if x != nil {
+		return x.RawFamily
+	}
+	return ""
+}
+
+// ModelMetadataResponse contains per-model classification metadata without any grouping
+type ModelMetadataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Models        []*ModelMetadata       `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModelMetadataResponse) Reset() {
+	*x = ModelMetadataResponse{}
+	mi := &file_models_proto_models_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelMetadataResponse) ProtoMessage() {}
+
+func (x *ModelMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelMetadataResponse.ProtoReflect.Descriptor instead.
+func (*ModelMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ModelMetadataResponse) GetModels() []*ModelMetadata {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+func (x *ModelMetadataResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// RecommendationRequest describes the constraints a recommended model must satisfy
+type RecommendationRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Models                  []*Model               `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
+	RequiredCapabilities    []string               `protobuf:"bytes,2,rep,name=required_capabilities,json=requiredCapabilities,proto3" json:"required_capabilities,omitempty"`
+	MinContextSize          int32                  `protobuf:"varint,3,opt,name=min_context_size,json=minContextSize,proto3" json:"min_context_size,omitempty"`
+	MaxInputPricePerMillion float64                `protobuf:"fixed64,4,opt,name=max_input_price_per_million,json=maxInputPricePerMillion,proto3" json:"max_input_price_per_million,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RecommendationRequest) Reset() {
+	*x = RecommendationRequest{}
+	mi := &file_models_proto_models_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendationRequest) ProtoMessage() {}
+
+func (x *RecommendationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendationRequest.ProtoReflect.Descriptor instead.
+func (*RecommendationRequest) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RecommendationRequest) GetModels() []*Model {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+func (x *RecommendationRequest) GetRequiredCapabilities() []string {
+	if x != nil {
+		return x.RequiredCapabilities
+	}
+	return nil
+}
+
+func (x *RecommendationRequest) GetMinContextSize() int32 {
+	if x != nil {
+		return x.MinContextSize
+	}
+	return 0
+}
+
+func (x *RecommendationRequest) GetMaxInputPricePerMillion() float64 {
+	if x != nil {
+		return x.MaxInputPricePerMillion
+	}
+	return 0
+}
+
+// RecommendationResponse contains the recommended model, if any
+type RecommendationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Model         *Model                 `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendationResponse) Reset() {
+	*x = RecommendationResponse{}
+	mi := &file_models_proto_models_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendationResponse) ProtoMessage() {}
+
+func (x *RecommendationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendationResponse.ProtoReflect.Descriptor instead.
+func (*RecommendationResponse) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RecommendationResponse) GetModel() *Model {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+func (x *RecommendationResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// SingleModelRequest identifies a single model to classify
+type SingleModelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SingleModelRequest) Reset() {
+	*x = SingleModelRequest{}
+	mi := &file_models_proto_models_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SingleModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleModelRequest) ProtoMessage() {}
+
+func (x *SingleModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SingleModelRequest.ProtoReflect.Descriptor instead.
+func (*SingleModelRequest) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SingleModelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SingleModelRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+// TraceStep records the rule that decided one classification field
+type TraceStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // "provider", "series", "type", "variant", "family", "capability", ...
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Rule          string                 `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"` // e.g. "provider pattern \"claude\"" or "registry entry \"gpt-4o\""
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TraceStep) Reset() {
+	*x = TraceStep{}
+	mi := &file_models_proto_models_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TraceStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceStep) ProtoMessage() {}
+
+func (x *TraceStep) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceStep.ProtoReflect.Descriptor instead.
+func (*TraceStep) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TraceStep) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *TraceStep) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *TraceStep) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+// ModelTrace is a classified model with the rules that produced its labels
+type ModelTrace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Model         *Model                 `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	Steps         []*TraceStep           `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModelTrace) Reset() {
+	*x = ModelTrace{}
+	mi := &file_models_proto_models_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelTrace) ProtoMessage() {}
+
+func (x *ModelTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelTrace.ProtoReflect.Descriptor instead.
+func (*ModelTrace) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ModelTrace) GetModel() *Model {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+func (x *ModelTrace) GetSteps() []*TraceStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// CompareModelsRequest identifies the two models to compare
+type CompareModelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	A             *SingleModelRequest    `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	B             *SingleModelRequest    `protobuf:"bytes,2,opt,name=b,proto3" json:"b,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareModelsRequest) Reset() {
+	*x = CompareModelsRequest{}
+	mi := &file_models_proto_models_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareModelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareModelsRequest) ProtoMessage() {}
+
+func (x *CompareModelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareModelsRequest.ProtoReflect.Descriptor instead.
+func (*CompareModelsRequest) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CompareModelsRequest) GetA() *SingleModelRequest {
+	if x != nil {
+		return x.A
+	}
+	return nil
+}
+
+func (x *CompareModelsRequest) GetB() *SingleModelRequest {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+// FieldComparison compares one field of two models
+type FieldComparison struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // "context_size", "max_tokens", "cost_per_token", "is_multimodal", "provider", "family", "type"
+	A             string                 `protobuf:"bytes,2,opt,name=a,proto3" json:"a,omitempty"`
+	B             string                 `protobuf:"bytes,3,opt,name=b,proto3" json:"b,omitempty"`
+	Result        string                 `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"` // "equal", "a_greater" or "b_greater"; "different" for provider, family and type
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldComparison) Reset() {
+	*x = FieldComparison{}
+	mi := &file_models_proto_models_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldComparison) ProtoMessage() {}
+
+func (x *FieldComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldComparison.ProtoReflect.Descriptor instead.
+func (*FieldComparison) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FieldComparison) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldComparison) GetA() string {
+	if x != nil {
+		return x.A
+	}
+	return ""
+}
+
+func (x *FieldComparison) GetB() string {
+	if x != nil {
+		return x.B
+	}
+	return ""
+}
+
+func (x *FieldComparison) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+// ModelComparison is a side-by-side comparison of two classified models
+type ModelComparison struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	A                  *Model                 `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	B                  *Model                 `protobuf:"bytes,2,opt,name=b,proto3" json:"b,omitempty"`
+	Fields             []*FieldComparison     `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	CommonCapabilities []string               `protobuf:"bytes,4,rep,name=common_capabilities,json=commonCapabilities,proto3" json:"common_capabilities,omitempty"`
+	OnlyACapabilities  []string               `protobuf:"bytes,5,rep,name=only_a_capabilities,json=onlyACapabilities,proto3" json:"only_a_capabilities,omitempty"`
+	OnlyBCapabilities  []string               `protobuf:"bytes,6,rep,name=only_b_capabilities,json=onlyBCapabilities,proto3" json:"only_b_capabilities,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ModelComparison) Reset() {
+	*x = ModelComparison{}
+	mi := &file_models_proto_models_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelComparison) ProtoMessage() {}
+
+func (x *ModelComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelComparison.ProtoReflect.Descriptor instead.
+func (*ModelComparison) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ModelComparison) GetA() *Model {
+	if x != nil {
+		return x.A
+	}
+	return nil
+}
+
+func (x *ModelComparison) GetB() *Model {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+func (x *ModelComparison) GetFields() []*FieldComparison {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ModelComparison) GetCommonCapabilities() []string {
+	if x != nil {
+		return x.CommonCapabilities
+	}
+	return nil
+}
+
+func (x *ModelComparison) GetOnlyACapabilities() []string {
+	if x != nil {
+		return x.OnlyACapabilities
+	}
+	return nil
+}
+
+func (x *ModelComparison) GetOnlyBCapabilities() []string {
+	if x != nil {
+		return x.OnlyBCapabilities
+	}
+	return nil
+}
+
+// ClassificationPropertiesRequest requests the available classification properties
+type ClassificationPropertiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassificationPropertiesRequest) Reset() {
+	*x = ClassificationPropertiesRequest{}
+	mi := &file_models_proto_models_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassificationPropertiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassificationPropertiesRequest) ProtoMessage() {}
+
+func (x *ClassificationPropertiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassificationPropertiesRequest.ProtoReflect.Descriptor instead.
+func (*ClassificationPropertiesRequest) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{18}
+}
+
+// ClassificationPropertiesResponse lists the classifiable properties and their possible values
+type ClassificationPropertiesResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Properties    []*ClassificationProperty `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClassificationPropertiesResponse) Reset() {
+	*x = ClassificationPropertiesResponse{}
+	mi := &file_models_proto_models_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClassificationPropertiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassificationPropertiesResponse) ProtoMessage() {}
+
+func (x *ClassificationPropertiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassificationPropertiesResponse.ProtoReflect.Descriptor instead.
+func (*ClassificationPropertiesResponse) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ClassificationPropertiesResponse) GetProperties() []*ClassificationProperty {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+// ModelValidation reports whether a submitted model ID is recognized
+type ModelValidation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Known         bool                   `protobuf:"varint,2,opt,name=known,proto3" json:"known,omitempty"` // True when the ID resolves to a known provider (not "other")
+	Provider      string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	CanonicalName string                 `protobuf:"bytes,4,opt,name=canonical_name,json=canonicalName,proto3" json:"canonical_name,omitempty"` // Resolved display name or variant
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModelValidation) Reset() {
+	*x = ModelValidation{}
+	mi := &file_models_proto_models_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelValidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelValidation) ProtoMessage() {}
+
+func (x *ModelValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelValidation.ProtoReflect.Descriptor instead.
+func (*ModelValidation) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ModelValidation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModelValidation) GetKnown() bool {
+	if x != nil {
+		return x.Known
+	}
+	return false
+}
+
+func (x *ModelValidation) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ModelValidation) GetCanonicalName() string {
+	if x != nil {
+		return x.CanonicalName
+	}
+	return ""
+}
+
+// ValidationResponse lists the validation result for each submitted model, in request order
+type ValidationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Models        []*ModelValidation     `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
+	UnknownCount  int32                  `protobuf:"varint,2,opt,name=unknown_count,json=unknownCount,proto3" json:"unknown_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidationResponse) Reset() {
+	*x = ValidationResponse{}
+	mi := &file_models_proto_models_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationResponse) ProtoMessage() {}
+
+func (x *ValidationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationResponse.ProtoReflect.Descriptor instead.
+func (*ValidationResponse) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ValidationResponse) GetModels() []*ModelValidation {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+func (x *ValidationResponse) GetUnknownCount() int32 {
+	if x != nil {
+		return x.UnknownCount
+	}
+	return 0
+}
+
+// FamilyModelsRequest selects the models of one family from a model list
+type FamilyModelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Models        []*Model               `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
+	Family        string                 `protobuf:"bytes,2,opt,name=family,proto3" json:"family,omitempty"` // Family or series, matched case-insensitively (e.g. "Claude" or "Claude 3")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FamilyModelsRequest) Reset() {
+	*x = FamilyModelsRequest{}
+	mi := &file_models_proto_models_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FamilyModelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FamilyModelsRequest) ProtoMessage() {}
+
+func (x *FamilyModelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FamilyModelsRequest.ProtoReflect.Descriptor instead.
+func (*FamilyModelsRequest) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FamilyModelsRequest) GetModels() []*Model {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+func (x *FamilyModelsRequest) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+// FamilyModelsResponse lists a family's models, newest first
+type FamilyModelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Models        []*Model               `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FamilyModelsResponse) Reset() {
+	*x = FamilyModelsResponse{}
+	mi := &file_models_proto_models_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FamilyModelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FamilyModelsResponse) ProtoMessage() {}
+
+func (x *FamilyModelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FamilyModelsResponse.ProtoReflect.Descriptor instead.
+func (*FamilyModelsResponse) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FamilyModelsResponse) GetModels() []*Model {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+// ProviderSummary contains aggregate statistics for one provider's models
+type ProviderSummary struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Provider        string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ModelCount      int32                  `protobuf:"varint,2,opt,name=model_count,json=modelCount,proto3" json:"model_count,omitempty"`
+	MultimodalCount int32                  `protobuf:"varint,3,opt,name=multimodal_count,json=multimodalCount,proto3" json:"multimodal_count,omitempty"`
+	DefaultCount    int32                  `protobuf:"varint,4,opt,name=default_count,json=defaultCount,proto3" json:"default_count,omitempty"`
+	MinContextSize  int32                  `protobuf:"varint,5,opt,name=min_context_size,json=minContextSize,proto3" json:"min_context_size,omitempty"` // Smallest known context size (0 when none is known)
+	MaxContextSize  int32                  `protobuf:"varint,6,opt,name=max_context_size,json=maxContextSize,proto3" json:"max_context_size,omitempty"`
+	FamilyCount     int32                  `protobuf:"varint,7,opt,name=family_count,json=familyCount,proto3" json:"family_count,omitempty"` // Number of distinct families
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProviderSummary) Reset() {
+	*x = ProviderSummary{}
+	mi := &file_models_proto_models_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderSummary) ProtoMessage() {}
+
+func (x *ProviderSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderSummary.ProtoReflect.Descriptor instead.
+func (*ProviderSummary) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ProviderSummary) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ProviderSummary) GetModelCount() int32 {
+	if x != nil {
+		return x.ModelCount
+	}
+	return 0
+}
+
+func (x *ProviderSummary) GetMultimodalCount() int32 {
+	if x != nil {
+		return x.MultimodalCount
+	}
+	return 0
+}
+
+func (x *ProviderSummary) GetDefaultCount() int32 {
+	if x != nil {
+		return x.DefaultCount
+	}
+	return 0
+}
+
+func (x *ProviderSummary) GetMinContextSize() int32 {
+	if x != nil {
+		return x.MinContextSize
+	}
+	return 0
+}
+
+func (x *ProviderSummary) GetMaxContextSize() int32 {
+	if x != nil {
+		return x.MaxContextSize
+	}
+	return 0
+}
+
+func (x *ProviderSummary) GetFamilyCount() int32 {
+	if x != nil {
+		return x.FamilyCount
+	}
+	return 0
+}
+
+// ProvidersSummaryResponse lists per-provider statistics, sorted by provider
+type ProvidersSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Providers     []*ProviderSummary     `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProvidersSummaryResponse) Reset() {
+	*x = ProvidersSummaryResponse{}
+	mi := &file_models_proto_models_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProvidersSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvidersSummaryResponse) ProtoMessage() {}
+
+func (x *ProvidersSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvidersSummaryResponse.ProtoReflect.Descriptor instead.
+func (*ProvidersSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ProvidersSummaryResponse) GetProviders() []*ProviderSummary {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+// ServerInfoRequest requests build and runtime information about the server
+type ServerInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerInfoRequest) Reset() {
+	*x = ServerInfoRequest{}
+	mi := &file_models_proto_models_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInfoRequest) ProtoMessage() {}
+
+func (x *ServerInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInfoRequest.ProtoReflect.Descriptor instead.
+func (*ServerInfoRequest) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{26}
+}
+
+// ServerInfoResponse describes the running build
+type ServerInfoResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Version            string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Commit             string                 `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	BuildDate          string                 `protobuf:"bytes,3,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
+	UptimeSeconds      int64                  `protobuf:"varint,4,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	PatternCount       int32                  `protobuf:"varint,5,opt,name=pattern_count,json=patternCount,proto3" json:"pattern_count,omitempty"`                     // Number of name patterns known to the classifier
+	RegistryModelCount int32                  `protobuf:"varint,6,opt,name=registry_model_count,json=registryModelCount,proto3" json:"registry_model_count,omitempty"` // Number of models in the embedded registry
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ServerInfoResponse) Reset() {
+	*x = ServerInfoResponse{}
+	mi := &file_models_proto_models_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInfoResponse) ProtoMessage() {}
+
+func (x *ServerInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInfoResponse.ProtoReflect.Descriptor instead.
+func (*ServerInfoResponse) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ServerInfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ServerInfoResponse) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *ServerInfoResponse) GetBuildDate() string {
+	if x != nil {
+		return x.BuildDate
+	}
+	return ""
+}
+
+func (x *ServerInfoResponse) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *ServerInfoResponse) GetPatternCount() int32 {
+	if x != nil {
+		return x.PatternCount
+	}
+	return 0
+}
+
+func (x *ServerInfoResponse) GetRegistryModelCount() int32 {
+	if x != nil {
+		return x.RegistryModelCount
+	}
+	return 0
+}
+
+// ReloadConfigurationRequest asks the server to re-read its classifier configuration.
+// The caller authenticates with an "authorization: Bearer <admin token>" metadata entry.
+type ReloadConfigurationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadConfigurationRequest) Reset() {
+	*x = ReloadConfigurationRequest{}
+	mi := &file_models_proto_models_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigurationRequest) ProtoMessage() {}
+
+func (x *ReloadConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*ReloadConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{28}
+}
+
+// ReloadConfigurationResponse describes the classifier now serving requests
+type ReloadConfigurationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Generation    uint64                 `protobuf:"varint,1,opt,name=generation,proto3" json:"generation,omitempty"`                         // Increases with every reload
+	PatternCount  int32                  `protobuf:"varint,2,opt,name=pattern_count,json=patternCount,proto3" json:"pattern_count,omitempty"` // Number of name patterns known to the reloaded classifier
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadConfigurationResponse) Reset() {
+	*x = ReloadConfigurationResponse{}
+	mi := &file_models_proto_models_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigurationResponse) ProtoMessage() {}
+
+func (x *ReloadConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_models_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*ReloadConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_models_proto_models_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReloadConfigurationResponse) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *ReloadConfigurationResponse) GetPatternCount() int32 {
+	if x != nil {
+		return x.PatternCount
+	}
+	return 0
+}
+
+var File_models_proto_models_proto protoreflect.FileDescriptor
+
+const file_models_proto_models_proto_rawDesc = "" +
+	"\n" +
+	"\x19models/proto/models.proto\x12\fmodelservice\"\xeb\t\n" +
+	"\x05Model\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\fcontext_size\x18\x03 \x01(\x05R\vcontextSize\x12\x1d\n" +
+	"\n" +
+	"max_tokens\x18\x04 \x01(\x05R\tmaxTokens\x12\x1a\n" +
+	"\bprovider\x18\x05 \x01(\tR\bprovider\x12!\n" +
+	"\fdisplay_name\x18\x06 \x01(\tR\vdisplayName\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12$\n" +
+	"\x0ecost_per_token\x18\b \x01(\x01R\fcostPerToken\x12\"\n" +
+	"\fcapabilities\x18\t \x03(\tR\fcapabilities\x12'\n" +
+	"\x0freasoning_tiers\x18# \x03(\tR\x0ereasoningTiers\x12\x16\n" +
+	"\x06family\x18\n" +
+	" \x01(\tR\x06family\x12\x12\n" +
+	"\x04type\x18\v \x01(\tR\x04type\x12\x16\n" +
+	"\x06series\x18\f \x01(\tR\x06series\x12\x18\n" +
+	"\avariant\x18\r \x01(\tR\avariant\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x0e \x01(\bR\tisDefault\x12#\n" +
+	"\ris_multimodal\x18\x0f \x01(\bR\fisMultimodal\x12'\n" +
+	"\x0fis_experimental\x18\x10 \x01(\bR\x0eisExperimental\x12\x18\n" +
+	"\aversion\x18\x11 \x01(\tR\aversion\x12\"\n" +
+	"\fquantization\x18\x12 \x01(\tR\fquantization\x12+\n" +
+	"\x11original_provider\x18\x13 \x01(\tR\x10originalProvider\x12\x18\n" +
+	"\alicense\x18\x15 \x01(\tR\alicense\x12$\n" +
+	"\x0eis_open_weight\x18\x16 \x01(\bR\fisOpenWeight\x12\x16\n" +
+	"\x06tuning\x18\x17 \x01(\tR\x06tuning\x12.\n" +
+	"\x13family_display_name\x18\x18 \x01(\tR\x11familyDisplayName\x12)\n" +
+	"\x10input_modalities\x18\x19 \x03(\tR\x0finputModalities\x12+\n" +
+	"\x11output_modalities\x18\x1a \x03(\tR\x10outputModalities\x12\x12\n" +
+	"\x04tags\x18\x1b \x03(\tR\x04tags\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x1c \x01(\x02R\n" +
+	"confidence\x12!\n" +
+	"\fis_distilled\x18\x1d \x01(\bR\visDistilled\x124\n" +
+	"\x16context_chars_estimate\x18\x1e \x01(\x05R\x14contextCharsEstimate\x12'\n" +
+	"\x0frouting_variant\x18\x1f \x01(\tR\x0eroutingVariant\x12\x1d\n" +
+	"\n" +
+	"raw_family\x18  \x01(\tR\trawFamily\x12\"\n" +
+	"\ris_fine_tuned\x18! \x01(\bR\visFineTuned\x12\x1d\n" +
+	"\n" +
+	"base_model\x18\" \x01(\tR\tbaseModel\x12=\n" +
+	"\bmetadata\x18\x14 \x03(\v2!.modelservice.Model.MetadataEntryR\bmetadata\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8e\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x99\x02\n" +
 	"\x0fLoadedModelList\x12+\n" +
 	"\x06models\x18\x01 \x03(\v2\x13.modelservice.ModelR\x06models\x12)\n" +
 	"\x10default_provider\x18\x02 \x01(\tR\x0fdefaultProvider\x12#\n" +
-	"\rdefault_model\x18\x03 \x01(\tR\fdefaultModel\"\x9a\x01\n" +
+	"\rdefault_model\x18\x03 \x01(\tR\fdefaultModel\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\tR\trequestId\x12'\n" +
+	"\x0fkeep_duplicates\x18\x05 \x01(\bR\x0ekeepDuplicates\x12)\n" +
+	"\x10skip_enhancement\x18\x06 \x01(\bR\x0fskipEnhancement\x12\x16\n" +
+	"\x06fields\x18\a \x03(\tR\x06fields\"\x9a\x01\n" +
 	"\x16ClassificationProperty\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12 \n" +
@@ -652,7 +2530,7 @@ const file_models_proto_models_proto_rawDesc = "" +
 	"\x14ClassifiedModelGroup\x12#\n" +
 	"\rproperty_name\x18\x01 \x01(\tR\fpropertyName\x12%\n" +
 	"\x0eproperty_value\x18\x02 \x01(\tR\rpropertyValue\x12+\n" +
-	"\x06models\x18\x03 \x03(\v2\x13.modelservice.ModelR\x06models\"\xe8\x01\n" +
+	"\x06models\x18\x03 \x03(\v2\x13.modelservice.ModelR\x06models\"\xda\x06\n" +
 	"\x16ClassificationCriteria\x12\x1e\n" +
 	"\n" +
 	"properties\x18\x01 \x03(\tR\n" +
@@ -660,22 +2538,186 @@ const file_models_proto_models_proto_rawDesc = "" +
 	"\x14include_experimental\x18\x02 \x01(\bR\x13includeExperimental\x12-\n" +
 	"\x12include_deprecated\x18\x03 \x01(\bR\x11includeDeprecated\x12(\n" +
 	"\x10min_context_size\x18\x04 \x01(\x05R\x0eminContextSize\x12\"\n" +
-	"\fhierarchical\x18\x05 \x01(\bR\fhierarchical\"\xbf\x02\n" +
+	"\fhierarchical\x18\x05 \x01(\bR\fhierarchical\x12+\n" +
+	"\x11provider_grouping\x18\x06 \x01(\tR\x10providerGrouping\x121\n" +
+	"\x14hierarchy_dimensions\x18\a \x03(\tR\x13hierarchyDimensions\x12\x17\n" +
+	"\asort_by\x18\b \x01(\tR\x06sortBy\x12(\n" +
+	"\x10max_context_size\x18\t \x01(\x05R\x0emaxContextSize\x12$\n" +
+	"\x0efilter_by_tags\x18\n" +
+	" \x03(\tR\ffilterByTags\x12$\n" +
+	"\x0ematch_all_tags\x18\v \x01(\bR\fmatchAllTags\x12\x1d\n" +
+	"\n" +
+	"both_views\x18\f \x01(\bR\tbothViews\x12'\n" +
+	"\x0fprefer_defaults\x18\r \x01(\bR\x0epreferDefaults\x124\n" +
+	"\x16include_chars_estimate\x18\x0e \x01(\bR\x14includeCharsEstimate\x12'\n" +
+	"\x0fkeep_duplicates\x18\x0f \x01(\bR\x0ekeepDuplicates\x12,\n" +
+	"\x12metadata_group_key\x18\x10 \x01(\tR\x10metadataGroupKey\x12#\n" +
+	"\rskeleton_only\x18\x11 \x01(\bR\fskeletonOnly\x12\x16\n" +
+	"\x06format\x18\x12 \x01(\tR\x06format\x12'\n" +
+	"\x0fmultimodal_only\x18\x13 \x01(\bR\x0emultimodalOnly\x12\x16\n" +
+	"\x06fields\x18\x14 \x03(\tR\x06fields\x12.\n" +
+	"\x13max_hierarchy_depth\x18\x15 \x01(\x05R\x11maxHierarchyDepth\"\xb7\x03\n" +
 	"\x17ClassifiedModelResponse\x12O\n" +
 	"\x11classified_groups\x18\x01 \x03(\v2\".modelservice.ClassifiedModelGroupR\x10classifiedGroups\x12W\n" +
 	"\x14available_properties\x18\x02 \x03(\v2$.modelservice.ClassificationPropertyR\x13availableProperties\x12#\n" +
 	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\x12U\n" +
-	"\x13hierarchical_groups\x18\x04 \x03(\v2$.modelservice.HierarchicalModelGroupR\x12hierarchicalGroups\"\xc7\x01\n" +
+	"\x13hierarchical_groups\x18\x04 \x03(\v2$.modelservice.HierarchicalModelGroupR\x12hierarchicalGroups\x12=\n" +
+	"\asummary\x18\x05 \x01(\v2#.modelservice.ClassificationSummaryR\asummary\x12\x1b\n" +
+	"\ttree_json\x18\x06 \x01(\tR\btreeJson\x12\x1a\n" +
+	"\bwarnings\x18\a \x03(\tR\bwarnings\"\xf9\x05\n" +
+	"\x15ClassificationSummary\x12!\n" +
+	"\ftotal_models\x18\x01 \x01(\x05R\vtotalModels\x12`\n" +
+	"\x0fprovider_counts\x18\x02 \x03(\v27.modelservice.ClassificationSummary.ProviderCountsEntryR\x0eproviderCounts\x12T\n" +
+	"\vtype_counts\x18\x03 \x03(\v23.modelservice.ClassificationSummary.TypeCountsEntryR\n" +
+	"typeCounts\x12f\n" +
+	"\x11capability_counts\x18\x04 \x03(\v29.modelservice.ClassificationSummary.CapabilityCountsEntryR\x10capabilityCounts\x12)\n" +
+	"\x10multimodal_count\x18\x05 \x01(\x05R\x0fmultimodalCount\x12-\n" +
+	"\x12experimental_count\x18\x06 \x01(\x05R\x11experimentalCount\x12(\n" +
+	"\x10min_context_size\x18\a \x01(\x05R\x0eminContextSize\x12(\n" +
+	"\x10max_context_size\x18\b \x01(\x05R\x0emaxContextSize\x12(\n" +
+	"\x10avg_context_size\x18\t \x01(\x01R\x0eavgContextSize\x1aA\n" +
+	"\x13ProviderCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a=\n" +
+	"\x0fTypeCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1aC\n" +
+	"\x15CapabilityCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xe8\x01\n" +
 	"\x16HierarchicalModelGroup\x12\x1d\n" +
 	"\n" +
 	"group_name\x18\x01 \x01(\tR\tgroupName\x12\x1f\n" +
 	"\vgroup_value\x18\x02 \x01(\tR\n" +
 	"groupValue\x12+\n" +
 	"\x06models\x18\x03 \x03(\v2\x13.modelservice.ModelR\x06models\x12@\n" +
-	"\bchildren\x18\x04 \x03(\v2$.modelservice.HierarchicalModelGroupR\bchildren2\xe3\x01\n" +
+	"\bchildren\x18\x04 \x03(\v2$.modelservice.HierarchicalModelGroupR\bchildren\x12\x1f\n" +
+	"\vmodel_count\x18\x05 \x01(\x05R\n" +
+	"modelCount\"\xd7\x05\n" +
+	"\rModelMetadata\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x16\n" +
+	"\x06series\x18\x03 \x01(\tR\x06series\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x18\n" +
+	"\avariant\x18\x05 \x01(\tR\avariant\x12!\n" +
+	"\fcontext_size\x18\x06 \x01(\x05R\vcontextSize\x12\"\n" +
+	"\fcapabilities\x18\a \x03(\tR\fcapabilities\x12#\n" +
+	"\ris_multimodal\x18\b \x01(\bR\fisMultimodal\x12'\n" +
+	"\x0fis_experimental\x18\t \x01(\bR\x0eisExperimental\x12!\n" +
+	"\fdisplay_name\x18\n" +
+	" \x01(\tR\vdisplayName\x12\"\n" +
+	"\fquantization\x18\v \x01(\tR\fquantization\x12\x18\n" +
+	"\alicense\x18\f \x01(\tR\alicense\x12$\n" +
+	"\x0eis_open_weight\x18\r \x01(\bR\fisOpenWeight\x12\x16\n" +
+	"\x06family\x18\x0e \x01(\tR\x06family\x12\x16\n" +
+	"\x06tuning\x18\x0f \x01(\tR\x06tuning\x12)\n" +
+	"\x10input_modalities\x18\x10 \x03(\tR\x0finputModalities\x12+\n" +
+	"\x11output_modalities\x18\x11 \x03(\tR\x10outputModalities\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x12 \x01(\x02R\n" +
+	"confidence\x12!\n" +
+	"\fis_distilled\x18\x13 \x01(\bR\visDistilled\x12%\n" +
+	"\x0edistilled_from\x18\x14 \x01(\tR\rdistilledFrom\x12'\n" +
+	"\x0frouting_variant\x18\x15 \x01(\tR\x0eroutingVariant\x12\x1d\n" +
+	"\n" +
+	"raw_family\x18\x16 \x01(\tR\trawFamily\"q\n" +
+	"\x15ModelMetadataResponse\x123\n" +
+	"\x06models\x18\x01 \x03(\v2\x1b.modelservice.ModelMetadataR\x06models\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\xe1\x01\n" +
+	"\x15RecommendationRequest\x12+\n" +
+	"\x06models\x18\x01 \x03(\v2\x13.modelservice.ModelR\x06models\x123\n" +
+	"\x15required_capabilities\x18\x02 \x03(\tR\x14requiredCapabilities\x12(\n" +
+	"\x10min_context_size\x18\x03 \x01(\x05R\x0eminContextSize\x12<\n" +
+	"\x1bmax_input_price_per_million\x18\x04 \x01(\x01R\x17maxInputPricePerMillion\"h\n" +
+	"\x16RecommendationResponse\x12)\n" +
+	"\x05model\x18\x01 \x01(\v2\x13.modelservice.ModelR\x05model\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"@\n" +
+	"\x12SingleModelRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\"K\n" +
+	"\tTraceStep\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x12\n" +
+	"\x04rule\x18\x03 \x01(\tR\x04rule\"f\n" +
+	"\n" +
+	"ModelTrace\x12)\n" +
+	"\x05model\x18\x01 \x01(\v2\x13.modelservice.ModelR\x05model\x12-\n" +
+	"\x05steps\x18\x02 \x03(\v2\x17.modelservice.TraceStepR\x05steps\"v\n" +
+	"\x14CompareModelsRequest\x12.\n" +
+	"\x01a\x18\x01 \x01(\v2 .modelservice.SingleModelRequestR\x01a\x12.\n" +
+	"\x01b\x18\x02 \x01(\v2 .modelservice.SingleModelRequestR\x01b\"[\n" +
+	"\x0fFieldComparison\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\f\n" +
+	"\x01a\x18\x02 \x01(\tR\x01a\x12\f\n" +
+	"\x01b\x18\x03 \x01(\tR\x01b\x12\x16\n" +
+	"\x06result\x18\x04 \x01(\tR\x06result\"\x9f\x02\n" +
+	"\x0fModelComparison\x12!\n" +
+	"\x01a\x18\x01 \x01(\v2\x13.modelservice.ModelR\x01a\x12!\n" +
+	"\x01b\x18\x02 \x01(\v2\x13.modelservice.ModelR\x01b\x125\n" +
+	"\x06fields\x18\x03 \x03(\v2\x1d.modelservice.FieldComparisonR\x06fields\x12/\n" +
+	"\x13common_capabilities\x18\x04 \x03(\tR\x12commonCapabilities\x12.\n" +
+	"\x13only_a_capabilities\x18\x05 \x03(\tR\x11onlyACapabilities\x12.\n" +
+	"\x13only_b_capabilities\x18\x06 \x03(\tR\x11onlyBCapabilities\"!\n" +
+	"\x1fClassificationPropertiesRequest\"h\n" +
+	" ClassificationPropertiesResponse\x12D\n" +
+	"\n" +
+	"properties\x18\x01 \x03(\v2$.modelservice.ClassificationPropertyR\n" +
+	"properties\"z\n" +
+	"\x0fModelValidation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05known\x18\x02 \x01(\bR\x05known\x12\x1a\n" +
+	"\bprovider\x18\x03 \x01(\tR\bprovider\x12%\n" +
+	"\x0ecanonical_name\x18\x04 \x01(\tR\rcanonicalName\"p\n" +
+	"\x12ValidationResponse\x125\n" +
+	"\x06models\x18\x01 \x03(\v2\x1d.modelservice.ModelValidationR\x06models\x12#\n" +
+	"\runknown_count\x18\x02 \x01(\x05R\funknownCount\"Z\n" +
+	"\x13FamilyModelsRequest\x12+\n" +
+	"\x06models\x18\x01 \x03(\v2\x13.modelservice.ModelR\x06models\x12\x16\n" +
+	"\x06family\x18\x02 \x01(\tR\x06family\"C\n" +
+	"\x14FamilyModelsResponse\x12+\n" +
+	"\x06models\x18\x01 \x03(\v2\x13.modelservice.ModelR\x06models\"\x95\x02\n" +
+	"\x0fProviderSummary\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x1f\n" +
+	"\vmodel_count\x18\x02 \x01(\x05R\n" +
+	"modelCount\x12)\n" +
+	"\x10multimodal_count\x18\x03 \x01(\x05R\x0fmultimodalCount\x12#\n" +
+	"\rdefault_count\x18\x04 \x01(\x05R\fdefaultCount\x12(\n" +
+	"\x10min_context_size\x18\x05 \x01(\x05R\x0eminContextSize\x12(\n" +
+	"\x10max_context_size\x18\x06 \x01(\x05R\x0emaxContextSize\x12!\n" +
+	"\ffamily_count\x18\a \x01(\x05R\vfamilyCount\"W\n" +
+	"\x18ProvidersSummaryResponse\x12;\n" +
+	"\tproviders\x18\x01 \x03(\v2\x1d.modelservice.ProviderSummaryR\tproviders\"\x13\n" +
+	"\x11ServerInfoRequest\"\xe3\x01\n" +
+	"\x12ServerInfoResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12\x16\n" +
+	"\x06commit\x18\x02 \x01(\tR\x06commit\x12\x1d\n" +
+	"\n" +
+	"build_date\x18\x03 \x01(\tR\tbuildDate\x12%\n" +
+	"\x0euptime_seconds\x18\x04 \x01(\x03R\ruptimeSeconds\x12#\n" +
+	"\rpattern_count\x18\x05 \x01(\x05R\fpatternCount\x120\n" +
+	"\x14registry_model_count\x18\x06 \x01(\x05R\x12registryModelCount\"\x1c\n" +
+	"\x1aReloadConfigurationRequest\"b\n" +
+	"\x1bReloadConfigurationResponse\x12\x1e\n" +
+	"\n" +
+	"generation\x18\x01 \x01(\x04R\n" +
+	"generation\x12#\n" +
+	"\rpattern_count\x18\x02 \x01(\x05R\fpatternCount2\xcc\n" +
+	"\n" +
 	"\x1aModelClassificationService\x12X\n" +
 	"\x0eClassifyModels\x12\x1d.modelservice.LoadedModelList\x1a%.modelservice.ClassifiedModelResponse\"\x00\x12k\n" +
-	"\x1aClassifyModelsWithCriteria\x12$.modelservice.ClassificationCriteria\x1a%.modelservice.ClassifiedModelResponse\"\x00B4Z2github.com/chat-api/model-categorizer/models/protob\x06proto3"
+	"\x1aClassifyModelsWithCriteria\x12$.modelservice.ClassificationCriteria\x1a%.modelservice.ClassifiedModelResponse\"\x00\x12]\n" +
+	"\x13GetMultimodalModels\x12\x1d.modelservice.LoadedModelList\x1a%.modelservice.ClassifiedModelResponse\"\x00\x12Y\n" +
+	"\x11GetModelsMetadata\x12\x1d.modelservice.LoadedModelList\x1a#.modelservice.ModelMetadataResponse\"\x00\x12]\n" +
+	"\x0eRecommendModel\x12#.modelservice.RecommendationRequest\x1a$.modelservice.RecommendationResponse\"\x00\x12N\n" +
+	"\x13ClassifySingleModel\x12 .modelservice.SingleModelRequest\x1a\x13.modelservice.Model\"\x00\x12Q\n" +
+	"\x11ClassifyWithTrace\x12 .modelservice.SingleModelRequest\x1a\x18.modelservice.ModelTrace\"\x00\x12T\n" +
+	"\rCompareModels\x12\".modelservice.CompareModelsRequest\x1a\x1d.modelservice.ModelComparison\"\x00\x12~\n" +
+	"\x1bGetClassificationProperties\x12-.modelservice.ClassificationPropertiesRequest\x1a..modelservice.ClassificationPropertiesResponse\"\x00\x12\\\n" +
+	"\x11GetModelsByFamily\x12!.modelservice.FamilyModelsRequest\x1a\".modelservice.FamilyModelsResponse\"\x00\x12^\n" +
+	"\x13GetProvidersSummary\x12\x1d.modelservice.LoadedModelList\x1a&.modelservice.ProvidersSummaryResponse\"\x00\x12S\n" +
+	"\x0eValidateModels\x12\x1d.modelservice.LoadedModelList\x1a .modelservice.ValidationResponse\"\x00\x12T\n" +
+	"\rGetServerInfo\x12\x1f.modelservice.ServerInfoRequest\x1a .modelservice.ServerInfoResponse\"\x00\x12l\n" +
+	"\x13ReloadConfiguration\x12(.modelservice.ReloadConfigurationRequest\x1a).modelservice.ReloadConfigurationResponse\"\x00B4Z2github.com/chat-api/model-categorizer/models/protob\x06proto3"
 
 var (
 	file_models_proto_models_proto_rawDescOnce sync.Once
@@ -689,35 +2731,104 @@ func file_models_proto_models_proto_rawDescGZIP() []byte {
 	return file_models_proto_models_proto_rawDescData
 }
 
-var file_models_proto_models_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_models_proto_models_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_models_proto_models_proto_goTypes = []any{
-	(*Model)(nil),                   // 0: modelservice.Model
-	(*LoadedModelList)(nil),         // 1: modelservice.LoadedModelList
-	(*ClassificationProperty)(nil),  // 2: modelservice.ClassificationProperty
-	(*ClassifiedModelGroup)(nil),    // 3: modelservice.ClassifiedModelGroup
-	(*ClassificationCriteria)(nil),  // 4: modelservice.ClassificationCriteria
-	(*ClassifiedModelResponse)(nil), // 5: modelservice.ClassifiedModelResponse
-	(*HierarchicalModelGroup)(nil),  // 6: modelservice.HierarchicalModelGroup
-	nil,                             // 7: modelservice.Model.MetadataEntry
+	(*Model)(nil),                            // 0: modelservice.Model
+	(*LoadedModelList)(nil),                  // 1: modelservice.LoadedModelList
+	(*ClassificationProperty)(nil),           // 2: modelservice.ClassificationProperty
+	(*ClassifiedModelGroup)(nil),             // 3: modelservice.ClassifiedModelGroup
+	(*ClassificationCriteria)(nil),           // 4: modelservice.ClassificationCriteria
+	(*ClassifiedModelResponse)(nil),          // 5: modelservice.ClassifiedModelResponse
+	(*ClassificationSummary)(nil),            // 6: modelservice.ClassificationSummary
+	(*HierarchicalModelGroup)(nil),           // 7: modelservice.HierarchicalModelGroup
+	(*ModelMetadata)(nil),                    // 8: modelservice.ModelMetadata
+	(*ModelMetadataResponse)(nil),            // 9: modelservice.ModelMetadataResponse
+	(*RecommendationRequest)(nil),            // 10: modelservice.RecommendationRequest
+	(*RecommendationResponse)(nil),           // 11: modelservice.RecommendationResponse
+	(*SingleModelRequest)(nil),               // 12: modelservice.SingleModelRequest
+	(*TraceStep)(nil),                        // 13: modelservice.TraceStep
+	(*ModelTrace)(nil),                       // 14: modelservice.ModelTrace
+	(*CompareModelsRequest)(nil),             // 15: modelservice.CompareModelsRequest
+	(*FieldComparison)(nil),                  // 16: modelservice.FieldComparison
+	(*ModelComparison)(nil),                  // 17: modelservice.ModelComparison
+	(*ClassificationPropertiesRequest)(nil),  // 18: modelservice.ClassificationPropertiesRequest
+	(*ClassificationPropertiesResponse)(nil), // 19: modelservice.ClassificationPropertiesResponse
+	(*ModelValidation)(nil),                  // 20: modelservice.ModelValidation
+	(*ValidationResponse)(nil),               // 21: modelservice.ValidationResponse
+	(*FamilyModelsRequest)(nil),              // 22: modelservice.FamilyModelsRequest
+	(*FamilyModelsResponse)(nil),             // 23: modelservice.FamilyModelsResponse
+	(*ProviderSummary)(nil),                  // 24: modelservice.ProviderSummary
+	(*ProvidersSummaryResponse)(nil),         // 25: modelservice.ProvidersSummaryResponse
+	(*ServerInfoRequest)(nil),                // 26: modelservice.ServerInfoRequest
+	(*ServerInfoResponse)(nil),               // 27: modelservice.ServerInfoResponse
+	(*ReloadConfigurationRequest)(nil),       // 28: modelservice.ReloadConfigurationRequest
+	(*ReloadConfigurationResponse)(nil),      // 29: modelservice.ReloadConfigurationResponse
+	nil,                                      // 30: modelservice.Model.MetadataEntry
+	nil,                                      // 31: modelservice.ClassificationSummary.ProviderCountsEntry
+	nil,                                      // 32: modelservice.ClassificationSummary.TypeCountsEntry
+	nil,                                      // 33: modelservice.ClassificationSummary.CapabilityCountsEntry
 }
 var file_models_proto_models_proto_depIdxs = []int32{
-	7,  // 0: modelservice.Model.metadata:type_name -> modelservice.Model.MetadataEntry
+	30, // 0: modelservice.Model.metadata:type_name -> modelservice.Model.MetadataEntry
 	0,  // 1: modelservice.LoadedModelList.models:type_name -> modelservice.Model
 	0,  // 2: modelservice.ClassifiedModelGroup.models:type_name -> modelservice.Model
 	3,  // 3: modelservice.ClassifiedModelResponse.classified_groups:type_name -> modelservice.ClassifiedModelGroup
 	2,  // 4: modelservice.ClassifiedModelResponse.available_properties:type_name -> modelservice.ClassificationProperty
-	6,  // 5: modelservice.ClassifiedModelResponse.hierarchical_groups:type_name -> modelservice.HierarchicalModelGroup
-	0,  // 6: modelservice.HierarchicalModelGroup.models:type_name -> modelservice.Model
-	6,  // 7: modelservice.HierarchicalModelGroup.children:type_name -> modelservice.HierarchicalModelGroup
-	1,  // 8: modelservice.ModelClassificationService.ClassifyModels:input_type -> modelservice.LoadedModelList
-	4,  // 9: modelservice.ModelClassificationService.ClassifyModelsWithCriteria:input_type -> modelservice.ClassificationCriteria
-	5,  // 10: modelservice.ModelClassificationService.ClassifyModels:output_type -> modelservice.ClassifiedModelResponse
-	5,  // 11: modelservice.ModelClassificationService.ClassifyModelsWithCriteria:output_type -> modelservice.ClassifiedModelResponse
-	10, // [10:12] is the sub-list for method output_type
-	8,  // [8:10] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	7,  // 5: modelservice.ClassifiedModelResponse.hierarchical_groups:type_name -> modelservice.HierarchicalModelGroup
+	6,  // 6: modelservice.ClassifiedModelResponse.summary:type_name -> modelservice.ClassificationSummary
+	31, // 7: modelservice.ClassificationSummary.provider_counts:type_name -> modelservice.ClassificationSummary.ProviderCountsEntry
+	32, // 8: modelservice.ClassificationSummary.type_counts:type_name -> modelservice.ClassificationSummary.TypeCountsEntry
+	33, // 9: modelservice.ClassificationSummary.capability_counts:type_name -> modelservice.ClassificationSummary.CapabilityCountsEntry
+	0,  // 10: modelservice.HierarchicalModelGroup.models:type_name -> modelservice.Model
+	7,  // 11: modelservice.HierarchicalModelGroup.children:type_name -> modelservice.HierarchicalModelGroup
+	8,  // 12: modelservice.ModelMetadataResponse.models:type_name -> modelservice.ModelMetadata
+	0,  // 13: modelservice.RecommendationRequest.models:type_name -> modelservice.Model
+	0,  // 14: modelservice.RecommendationResponse.model:type_name -> modelservice.Model
+	0,  // 15: modelservice.ModelTrace.model:type_name -> modelservice.Model
+	13, // 16: modelservice.ModelTrace.steps:type_name -> modelservice.TraceStep
+	12, // 17: modelservice.CompareModelsRequest.a:type_name -> modelservice.SingleModelRequest
+	12, // 18: modelservice.CompareModelsRequest.b:type_name -> modelservice.SingleModelRequest
+	0,  // 19: modelservice.ModelComparison.a:type_name -> modelservice.Model
+	0,  // 20: modelservice.ModelComparison.b:type_name -> modelservice.Model
+	16, // 21: modelservice.ModelComparison.fields:type_name -> modelservice.FieldComparison
+	2,  // 22: modelservice.ClassificationPropertiesResponse.properties:type_name -> modelservice.ClassificationProperty
+	20, // 23: modelservice.ValidationResponse.models:type_name -> modelservice.ModelValidation
+	0,  // 24: modelservice.FamilyModelsRequest.models:type_name -> modelservice.Model
+	0,  // 25: modelservice.FamilyModelsResponse.models:type_name -> modelservice.Model
+	24, // 26: modelservice.ProvidersSummaryResponse.providers:type_name -> modelservice.ProviderSummary
+	1,  // 27: modelservice.ModelClassificationService.ClassifyModels:input_type -> modelservice.LoadedModelList
+	4,  // 28: modelservice.ModelClassificationService.ClassifyModelsWithCriteria:input_type -> modelservice.ClassificationCriteria
+	1,  // 29: modelservice.ModelClassificationService.GetMultimodalModels:input_type -> modelservice.LoadedModelList
+	1,  // 30: modelservice.ModelClassificationService.GetModelsMetadata:input_type -> modelservice.LoadedModelList
+	10, // 31: modelservice.ModelClassificationService.RecommendModel:input_type -> modelservice.RecommendationRequest
+	12, // 32: modelservice.ModelClassificationService.ClassifySingleModel:input_type -> modelservice.SingleModelRequest
+	12, // 33: modelservice.ModelClassificationService.ClassifyWithTrace:input_type -> modelservice.SingleModelRequest
+	15, // 34: modelservice.ModelClassificationService.CompareModels:input_type -> modelservice.CompareModelsRequest
+	18, // 35: modelservice.ModelClassificationService.GetClassificationProperties:input_type -> modelservice.ClassificationPropertiesRequest
+	22, // 36: modelservice.ModelClassificationService.GetModelsByFamily:input_type -> modelservice.FamilyModelsRequest
+	1,  // 37: modelservice.ModelClassificationService.GetProvidersSummary:input_type -> modelservice.LoadedModelList
+	1,  // 38: modelservice.ModelClassificationService.ValidateModels:input_type -> modelservice.LoadedModelList
+	26, // 39: modelservice.ModelClassificationService.GetServerInfo:input_type -> modelservice.ServerInfoRequest
+	28, // 40: modelservice.ModelClassificationService.ReloadConfiguration:input_type -> modelservice.ReloadConfigurationRequest
+	5,  // 41: modelservice.ModelClassificationService.ClassifyModels:output_type -> modelservice.ClassifiedModelResponse
+	5,  // 42: modelservice.ModelClassificationService.ClassifyModelsWithCriteria:output_type -> modelservice.ClassifiedModelResponse
+	5,  // 43: modelservice.ModelClassificationService.GetMultimodalModels:output_type -> modelservice.ClassifiedModelResponse
+	9,  // 44: modelservice.ModelClassificationService.GetModelsMetadata:output_type -> modelservice.ModelMetadataResponse
+	11, // 45: modelservice.ModelClassificationService.RecommendModel:output_type -> modelservice.RecommendationResponse
+	0,  // 46: modelservice.ModelClassificationService.ClassifySingleModel:output_type -> modelservice.Model
+	14, // 47: modelservice.ModelClassificationService.ClassifyWithTrace:output_type -> modelservice.ModelTrace
+	17, // 48: modelservice.ModelClassificationService.CompareModels:output_type -> modelservice.ModelComparison
+	19, // 49: modelservice.ModelClassificationService.GetClassificationProperties:output_type -> modelservice.ClassificationPropertiesResponse
+	23, // 50: modelservice.ModelClassificationService.GetModelsByFamily:output_type -> modelservice.FamilyModelsResponse
+	25, // 51: modelservice.ModelClassificationService.GetProvidersSummary:output_type -> modelservice.ProvidersSummaryResponse
+	21, // 52: modelservice.ModelClassificationService.ValidateModels:output_type -> modelservice.ValidationResponse
+	27, // 53: modelservice.ModelClassificationService.GetServerInfo:output_type -> modelservice.ServerInfoResponse
+	29, // 54: modelservice.ModelClassificationService.ReloadConfiguration:output_type -> modelservice.ReloadConfigurationResponse
+	41, // [41:55] is the sub-list for method output_type
+	27, // [27:41] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_models_proto_models_proto_init() }
@@ -731,7 +2842,7 @@ func file_models_proto_models_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_models_proto_models_proto_rawDesc), len(file_models_proto_models_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
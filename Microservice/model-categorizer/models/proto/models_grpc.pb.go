@@ -19,8 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ModelClassificationService_ClassifyModels_FullMethodName             = "/modelservice.ModelClassificationService/ClassifyModels"
-	ModelClassificationService_ClassifyModelsWithCriteria_FullMethodName = "/modelservice.ModelClassificationService/ClassifyModelsWithCriteria"
+	ModelClassificationService_ClassifyModels_FullMethodName              = "/modelservice.ModelClassificationService/ClassifyModels"
+	ModelClassificationService_ClassifyModelsWithCriteria_FullMethodName  = "/modelservice.ModelClassificationService/ClassifyModelsWithCriteria"
+	ModelClassificationService_GetMultimodalModels_FullMethodName         = "/modelservice.ModelClassificationService/GetMultimodalModels"
+	ModelClassificationService_GetModelsMetadata_FullMethodName           = "/modelservice.ModelClassificationService/GetModelsMetadata"
+	ModelClassificationService_RecommendModel_FullMethodName              = "/modelservice.ModelClassificationService/RecommendModel"
+	ModelClassificationService_ClassifySingleModel_FullMethodName         = "/modelservice.ModelClassificationService/ClassifySingleModel"
+	ModelClassificationService_ClassifyWithTrace_FullMethodName           = "/modelservice.ModelClassificationService/ClassifyWithTrace"
+	ModelClassificationService_CompareModels_FullMethodName               = "/modelservice.ModelClassificationService/CompareModels"
+	ModelClassificationService_GetClassificationProperties_FullMethodName = "/modelservice.ModelClassificationService/GetClassificationProperties"
+	ModelClassificationService_GetModelsByFamily_FullMethodName           = "/modelservice.ModelClassificationService/GetModelsByFamily"
+	ModelClassificationService_GetProvidersSummary_FullMethodName         = "/modelservice.ModelClassificationService/GetProvidersSummary"
+	ModelClassificationService_ValidateModels_FullMethodName              = "/modelservice.ModelClassificationService/ValidateModels"
+	ModelClassificationService_GetServerInfo_FullMethodName               = "/modelservice.ModelClassificationService/GetServerInfo"
+	ModelClassificationService_ReloadConfiguration_FullMethodName         = "/modelservice.ModelClassificationService/ReloadConfiguration"
 )
 
 // ModelClassificationServiceClient is the client API for ModelClassificationService service.
@@ -34,6 +46,30 @@ type ModelClassificationServiceClient interface {
 	// Classify models with criteria
 	// Use hierarchical=true in ClassificationCriteria to get hierarchical grouping
 	ClassifyModelsWithCriteria(ctx context.Context, in *ClassificationCriteria, opts ...grpc.CallOption) (*ClassifiedModelResponse, error)
+	// Classify models and return the tree of only the multimodal ones
+	GetMultimodalModels(ctx context.Context, in *LoadedModelList, opts ...grpc.CallOption) (*ClassifiedModelResponse, error)
+	// Get flat classification metadata for each model without sorting or building a hierarchy
+	GetModelsMetadata(ctx context.Context, in *LoadedModelList, opts ...grpc.CallOption) (*ModelMetadataResponse, error)
+	// Recommend the cheapest model that satisfies the required capabilities, context and budget
+	RecommendModel(ctx context.Context, in *RecommendationRequest, opts ...grpc.CallOption) (*RecommendationResponse, error)
+	// Classify a single model by ID and return it with all classification fields populated
+	ClassifySingleModel(ctx context.Context, in *SingleModelRequest, opts ...grpc.CallOption) (*Model, error)
+	// Classify a single model and report which pattern or registry rule decided each label
+	ClassifyWithTrace(ctx context.Context, in *SingleModelRequest, opts ...grpc.CallOption) (*ModelTrace, error)
+	// Classify two models by ID and compare their context, pricing, capabilities and provider
+	CompareModels(ctx context.Context, in *CompareModelsRequest, opts ...grpc.CallOption) (*ModelComparison, error)
+	// Get the classifiable properties and their possible values without classifying anything
+	GetClassificationProperties(ctx context.Context, in *ClassificationPropertiesRequest, opts ...grpc.CallOption) (*ClassificationPropertiesResponse, error)
+	// Get all models of a family, newest first by release date, falling back to version
+	GetModelsByFamily(ctx context.Context, in *FamilyModelsRequest, opts ...grpc.CallOption) (*FamilyModelsResponse, error)
+	// Get per-provider model counts and context coverage for a model list
+	GetProvidersSummary(ctx context.Context, in *LoadedModelList, opts ...grpc.CallOption) (*ProvidersSummaryResponse, error)
+	// Check which model IDs are recognized without building any hierarchy
+	ValidateModels(ctx context.Context, in *LoadedModelList, opts ...grpc.CallOption) (*ValidationResponse, error)
+	// Get the server's version, uptime and classifier statistics
+	GetServerInfo(ctx context.Context, in *ServerInfoRequest, opts ...grpc.CallOption) (*ServerInfoResponse, error)
+	// Re-read the classifier configuration and pattern file; requires the admin token
+	ReloadConfiguration(ctx context.Context, in *ReloadConfigurationRequest, opts ...grpc.CallOption) (*ReloadConfigurationResponse, error)
 }
 
 type modelClassificationServiceClient struct {
@@ -64,6 +100,126 @@ func (c *modelClassificationServiceClient) ClassifyModelsWithCriteria(ctx contex
 	return out, nil
 }
 
+func (c *modelClassificationServiceClient) GetMultimodalModels(ctx context.Context, in *LoadedModelList, opts ...grpc.CallOption) (*ClassifiedModelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClassifiedModelResponse)
+	err := c.cc.Invoke(ctx, ModelClassificationService_GetMultimodalModels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelClassificationServiceClient) GetModelsMetadata(ctx context.Context, in *LoadedModelList, opts ...grpc.CallOption) (*ModelMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModelMetadataResponse)
+	err := c.cc.Invoke(ctx, ModelClassificationService_GetModelsMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelClassificationServiceClient) RecommendModel(ctx context.Context, in *RecommendationRequest, opts ...grpc.CallOption) (*RecommendationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendationResponse)
+	err := c.cc.Invoke(ctx, ModelClassificationService_RecommendModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelClassificationServiceClient) ClassifySingleModel(ctx context.Context, in *SingleModelRequest, opts ...grpc.CallOption) (*Model, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Model)
+	err := c.cc.Invoke(ctx, ModelClassificationService_ClassifySingleModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelClassificationServiceClient) ClassifyWithTrace(ctx context.Context, in *SingleModelRequest, opts ...grpc.CallOption) (*ModelTrace, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModelTrace)
+	err := c.cc.Invoke(ctx, ModelClassificationService_ClassifyWithTrace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelClassificationServiceClient) CompareModels(ctx context.Context, in *CompareModelsRequest, opts ...grpc.CallOption) (*ModelComparison, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModelComparison)
+	err := c.cc.Invoke(ctx, ModelClassificationService_CompareModels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelClassificationServiceClient) GetClassificationProperties(ctx context.Context, in *ClassificationPropertiesRequest, opts ...grpc.CallOption) (*ClassificationPropertiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClassificationPropertiesResponse)
+	err := c.cc.Invoke(ctx, ModelClassificationService_GetClassificationProperties_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelClassificationServiceClient) GetModelsByFamily(ctx context.Context, in *FamilyModelsRequest, opts ...grpc.CallOption) (*FamilyModelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FamilyModelsResponse)
+	err := c.cc.Invoke(ctx, ModelClassificationService_GetModelsByFamily_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelClassificationServiceClient) GetProvidersSummary(ctx context.Context, in *LoadedModelList, opts ...grpc.CallOption) (*ProvidersSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProvidersSummaryResponse)
+	err := c.cc.Invoke(ctx, ModelClassificationService_GetProvidersSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelClassificationServiceClient) ValidateModels(ctx context.Context, in *LoadedModelList, opts ...grpc.CallOption) (*ValidationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidationResponse)
+	err := c.cc.Invoke(ctx, ModelClassificationService_ValidateModels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelClassificationServiceClient) GetServerInfo(ctx context.Context, in *ServerInfoRequest, opts ...grpc.CallOption) (*ServerInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServerInfoResponse)
+	err := c.cc.Invoke(ctx, ModelClassificationService_GetServerInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelClassificationServiceClient) ReloadConfiguration(ctx context.Context, in *ReloadConfigurationRequest, opts ...grpc.CallOption) (*ReloadConfigurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReloadConfigurationResponse)
+	err := c.cc.Invoke(ctx, ModelClassificationService_ReloadConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ModelClassificationServiceServer is the server API for ModelClassificationService service.
 // All implementations must embed UnimplementedModelClassificationServiceServer
 // for forward compatibility.
@@ -75,6 +231,30 @@ type ModelClassificationServiceServer interface {
 	// Classify models with criteria
 	// Use hierarchical=true in ClassificationCriteria to get hierarchical grouping
 	ClassifyModelsWithCriteria(context.Context, *ClassificationCriteria) (*ClassifiedModelResponse, error)
+	// Classify models and return the tree of only the multimodal ones
+	GetMultimodalModels(context.Context, *LoadedModelList) (*ClassifiedModelResponse, error)
+	// Get flat classification metadata for each model without sorting or building a hierarchy
+	GetModelsMetadata(context.Context, *LoadedModelList) (*ModelMetadataResponse, error)
+	// Recommend the cheapest model that satisfies the required capabilities, context and budget
+	RecommendModel(context.Context, *RecommendationRequest) (*RecommendationResponse, error)
+	// Classify a single model by ID and return it with all classification fields populated
+	ClassifySingleModel(context.Context, *SingleModelRequest) (*Model, error)
+	// Classify a single model and report which pattern or registry rule decided each label
+	ClassifyWithTrace(context.Context, *SingleModelRequest) (*ModelTrace, error)
+	// Classify two models by ID and compare their context, pricing, capabilities and provider
+	CompareModels(context.Context, *CompareModelsRequest) (*ModelComparison, error)
+	// Get the classifiable properties and their possible values without classifying anything
+	GetClassificationProperties(context.Context, *ClassificationPropertiesRequest) (*ClassificationPropertiesResponse, error)
+	// Get all models of a family, newest first by release date, falling back to version
+	GetModelsByFamily(context.Context, *FamilyModelsRequest) (*FamilyModelsResponse, error)
+	// Get per-provider model counts and context coverage for a model list
+	GetProvidersSummary(context.Context, *LoadedModelList) (*ProvidersSummaryResponse, error)
+	// Check which model IDs are recognized without building any hierarchy
+	ValidateModels(context.Context, *LoadedModelList) (*ValidationResponse, error)
+	// Get the server's version, uptime and classifier statistics
+	GetServerInfo(context.Context, *ServerInfoRequest) (*ServerInfoResponse, error)
+	// Re-read the classifier configuration and pattern file; requires the admin token
+	ReloadConfiguration(context.Context, *ReloadConfigurationRequest) (*ReloadConfigurationResponse, error)
 	mustEmbedUnimplementedModelClassificationServiceServer()
 }
 
@@ -91,6 +271,42 @@ func (UnimplementedModelClassificationServiceServer) ClassifyModels(context.Cont
 func (UnimplementedModelClassificationServiceServer) ClassifyModelsWithCriteria(context.Context, *ClassificationCriteria) (*ClassifiedModelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClassifyModelsWithCriteria not implemented")
 }
+func (UnimplementedModelClassificationServiceServer) GetMultimodalModels(context.Context, *LoadedModelList) (*ClassifiedModelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMultimodalModels not implemented")
+}
+func (UnimplementedModelClassificationServiceServer) GetModelsMetadata(context.Context, *LoadedModelList) (*ModelMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModelsMetadata not implemented")
+}
+func (UnimplementedModelClassificationServiceServer) RecommendModel(context.Context, *RecommendationRequest) (*RecommendationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendModel not implemented")
+}
+func (UnimplementedModelClassificationServiceServer) ClassifySingleModel(context.Context, *SingleModelRequest) (*Model, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassifySingleModel not implemented")
+}
+func (UnimplementedModelClassificationServiceServer) ClassifyWithTrace(context.Context, *SingleModelRequest) (*ModelTrace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassifyWithTrace not implemented")
+}
+func (UnimplementedModelClassificationServiceServer) CompareModels(context.Context, *CompareModelsRequest) (*ModelComparison, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareModels not implemented")
+}
+func (UnimplementedModelClassificationServiceServer) GetClassificationProperties(context.Context, *ClassificationPropertiesRequest) (*ClassificationPropertiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClassificationProperties not implemented")
+}
+func (UnimplementedModelClassificationServiceServer) GetModelsByFamily(context.Context, *FamilyModelsRequest) (*FamilyModelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModelsByFamily not implemented")
+}
+func (UnimplementedModelClassificationServiceServer) GetProvidersSummary(context.Context, *LoadedModelList) (*ProvidersSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProvidersSummary not implemented")
+}
+func (UnimplementedModelClassificationServiceServer) ValidateModels(context.Context, *LoadedModelList) (*ValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateModels not implemented")
+}
+func (UnimplementedModelClassificationServiceServer) GetServerInfo(context.Context, *ServerInfoRequest) (*ServerInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerInfo not implemented")
+}
+func (UnimplementedModelClassificationServiceServer) ReloadConfiguration(context.Context, *ReloadConfigurationRequest) (*ReloadConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfiguration not implemented")
+}
 func (UnimplementedModelClassificationServiceServer) mustEmbedUnimplementedModelClassificationServiceServer() {
 }
 func (UnimplementedModelClassificationServiceServer) testEmbeddedByValue() {}
@@ -149,6 +365,222 @@ func _ModelClassificationService_ClassifyModelsWithCriteria_Handler(srv interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ModelClassificationService_GetMultimodalModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadedModelList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelClassificationServiceServer).GetMultimodalModels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModelClassificationService_GetMultimodalModels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelClassificationServiceServer).GetMultimodalModels(ctx, req.(*LoadedModelList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelClassificationService_GetModelsMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadedModelList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelClassificationServiceServer).GetModelsMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModelClassificationService_GetModelsMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelClassificationServiceServer).GetModelsMetadata(ctx, req.(*LoadedModelList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelClassificationService_RecommendModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelClassificationServiceServer).RecommendModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModelClassificationService_RecommendModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelClassificationServiceServer).RecommendModel(ctx, req.(*RecommendationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelClassificationService_ClassifySingleModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelClassificationServiceServer).ClassifySingleModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModelClassificationService_ClassifySingleModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelClassificationServiceServer).ClassifySingleModel(ctx, req.(*SingleModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelClassificationService_ClassifyWithTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelClassificationServiceServer).ClassifyWithTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModelClassificationService_ClassifyWithTrace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelClassificationServiceServer).ClassifyWithTrace(ctx, req.(*SingleModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelClassificationService_CompareModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareModelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelClassificationServiceServer).CompareModels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModelClassificationService_CompareModels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelClassificationServiceServer).CompareModels(ctx, req.(*CompareModelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelClassificationService_GetClassificationProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClassificationPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelClassificationServiceServer).GetClassificationProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModelClassificationService_GetClassificationProperties_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelClassificationServiceServer).GetClassificationProperties(ctx, req.(*ClassificationPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelClassificationService_GetModelsByFamily_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FamilyModelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelClassificationServiceServer).GetModelsByFamily(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModelClassificationService_GetModelsByFamily_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelClassificationServiceServer).GetModelsByFamily(ctx, req.(*FamilyModelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelClassificationService_GetProvidersSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadedModelList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelClassificationServiceServer).GetProvidersSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModelClassificationService_GetProvidersSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelClassificationServiceServer).GetProvidersSummary(ctx, req.(*LoadedModelList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelClassificationService_ValidateModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadedModelList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelClassificationServiceServer).ValidateModels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModelClassificationService_ValidateModels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelClassificationServiceServer).ValidateModels(ctx, req.(*LoadedModelList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelClassificationService_GetServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelClassificationServiceServer).GetServerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModelClassificationService_GetServerInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelClassificationServiceServer).GetServerInfo(ctx, req.(*ServerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelClassificationService_ReloadConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelClassificationServiceServer).ReloadConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModelClassificationService_ReloadConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelClassificationServiceServer).ReloadConfiguration(ctx, req.(*ReloadConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ModelClassificationService_ServiceDesc is the grpc.ServiceDesc for ModelClassificationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -164,6 +596,54 @@ var ModelClassificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClassifyModelsWithCriteria",
 			Handler:    _ModelClassificationService_ClassifyModelsWithCriteria_Handler,
 		},
+		{
+			MethodName: "GetMultimodalModels",
+			Handler:    _ModelClassificationService_GetMultimodalModels_Handler,
+		},
+		{
+			MethodName: "GetModelsMetadata",
+			Handler:    _ModelClassificationService_GetModelsMetadata_Handler,
+		},
+		{
+			MethodName: "RecommendModel",
+			Handler:    _ModelClassificationService_RecommendModel_Handler,
+		},
+		{
+			MethodName: "ClassifySingleModel",
+			Handler:    _ModelClassificationService_ClassifySingleModel_Handler,
+		},
+		{
+			MethodName: "ClassifyWithTrace",
+			Handler:    _ModelClassificationService_ClassifyWithTrace_Handler,
+		},
+		{
+			MethodName: "CompareModels",
+			Handler:    _ModelClassificationService_CompareModels_Handler,
+		},
+		{
+			MethodName: "GetClassificationProperties",
+			Handler:    _ModelClassificationService_GetClassificationProperties_Handler,
+		},
+		{
+			MethodName: "GetModelsByFamily",
+			Handler:    _ModelClassificationService_GetModelsByFamily_Handler,
+		},
+		{
+			MethodName: "GetProvidersSummary",
+			Handler:    _ModelClassificationService_GetProvidersSummary_Handler,
+		},
+		{
+			MethodName: "ValidateModels",
+			Handler:    _ModelClassificationService_ValidateModels_Handler,
+		},
+		{
+			MethodName: "GetServerInfo",
+			Handler:    _ModelClassificationService_GetServerInfo_Handler,
+		},
+		{
+			MethodName: "ReloadConfiguration",
+			Handler:    _ModelClassificationService_ReloadConfiguration_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "models/proto/models.proto",
@@ -0,0 +1,62 @@
+package models
+
+import "sort"
+
+// ProviderSummary contains aggregate statistics for the models of a single provider
+type ProviderSummary struct {
+	Provider        string `json:"provider"`
+	ModelCount      int32  `json:"model_count"`
+	MultimodalCount int32  `json:"multimodal_count"`
+	DefaultCount    int32  `json:"default_count"`
+	MinContextSize  int32  `json:"min_context_size,omitempty"`
+	MaxContextSize  int32  `json:"max_context_size,omitempty"`
+	FamilyCount     int32  `json:"family_count"`
+}
+
+// SummarizeProviders aggregates classified models per provider in a single pass.
+// Results are sorted by provider name.
+func SummarizeProviders(modelsList []*Model) []*ProviderSummary {
+	byProvider := make(map[string]*ProviderSummary)
+	families := make(map[string]map[string]bool)
+
+	for _, model := range modelsList {
+		summary, ok := byProvider[model.Provider]
+		if !ok {
+			summary = &ProviderSummary{Provider: model.Provider}
+			byProvider[model.Provider] = summary
+			families[model.Provider] = make(map[string]bool)
+		}
+
+		summary.ModelCount++
+		if model.IsMultimodal {
+			summary.MultimodalCount++
+		}
+		if model.IsDefault {
+			summary.DefaultCount++
+		}
+
+		// Context statistics only consider models with a known context size
+		if model.ContextSize > 0 {
+			if summary.MinContextSize == 0 || model.ContextSize < summary.MinContextSize {
+				summary.MinContextSize = model.ContextSize
+			}
+			if model.ContextSize > summary.MaxContextSize {
+				summary.MaxContextSize = model.ContextSize
+			}
+		}
+
+		if model.Family != "" && !families[model.Provider][model.Family] {
+			families[model.Provider][model.Family] = true
+			summary.FamilyCount++
+		}
+	}
+
+	result := make([]*ProviderSummary, 0, len(byProvider))
+	for _, summary := range byProvider {
+		result = append(result, summary)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Provider < result[j].Provider
+	})
+	return result
+}
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrNoMatchingModel is returned when no model satisfies the recommendation constraints
+var ErrNoMatchingModel = errors.New("no model satisfies the recommendation constraints")
+
+// RecommendationRequest describes the constraints a recommended model must satisfy
+type RecommendationRequest struct {
+	Models                  []*Model `json:"models"`
+	RequiredCapabilities    []string `json:"required_capabilities,omitempty"`
+	MinContextSize          int32    `json:"min_context_size,omitempty"`
+	MaxInputPricePerMillion float64  `json:"max_input_price_per_million,omitempty"`
+}
+
+// InputPricePerMillion returns the model's input price per million tokens
+func (m *Model) InputPricePerMillion() float64 {
+	return m.CostPerToken * 1000000
+}
+
+// RecommendModel returns the cheapest model satisfying all constraints.
+// Ties on price are broken by the larger context window, then by the most capabilities.
+// Models without pricing are only recommended when no budget is set and no priced model qualifies.
+func RecommendModel(req RecommendationRequest) (*Model, error) {
+	var candidates []*Model
+	for _, model := range req.Models {
+		if req.MinContextSize > 0 && model.ContextSize < req.MinContextSize {
+			continue
+		}
+		if req.MaxInputPricePerMillion > 0 &&
+			(model.CostPerToken <= 0 || model.InputPricePerMillion() > req.MaxInputPricePerMillion) {
+			continue
+		}
+		if !hasAllCapabilities(model, req.RequiredCapabilities) {
+			continue
+		}
+		candidates = append(candidates, model)
+	}
+
+	if len(candidates) == 0 {
+		return nil, ErrNoMatchingModel
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		// Priced models come before models with unknown pricing
+		if (a.CostPerToken > 0) != (b.CostPerToken > 0) {
+			return a.CostPerToken > 0
+		}
+		if a.CostPerToken != b.CostPerToken {
+			return a.CostPerToken < b.CostPerToken
+		}
+		if a.ContextSize != b.ContextSize {
+			return a.ContextSize > b.ContextSize
+		}
+		return len(a.Capabilities) > len(b.Capabilities)
+	})
+
+	return candidates[0], nil
+}
+
+// hasAllCapabilities checks if a model has every required capability
+func hasAllCapabilities(model *Model, required []string) bool {
+	for _, capability := range required {
+		found := false
+		for _, modelCapability := range model.Capabilities {
+			if modelCapability == capability {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"errors"
+	"testing"
+)
+
+// recommendationCatalog is a small catalog with known prices per million input tokens
+func recommendationCatalog() []*Model {
+	perMillion := func(price float64) float64 { return price / 1000000 }
+	return []*Model{
+		{ID: "premium", ContextSize: 200000, CostPerToken: perMillion(15), Capabilities: []string{"chat", "vision", "function-calling"}},
+		{ID: "standard", ContextSize: 128000, CostPerToken: perMillion(2.5), Capabilities: []string{"chat", "vision", "function-calling"}},
+		{ID: "budget", ContextSize: 128000, CostPerToken: perMillion(0.15), Capabilities: []string{"chat", "function-calling"}},
+		{ID: "budget-long", ContextSize: 1000000, CostPerToken: perMillion(0.15), Capabilities: []string{"chat"}},
+		{ID: "unpriced", ContextSize: 32000, Capabilities: []string{"chat", "vision"}},
+	}
+}
+
+func TestRecommendModel(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     RecommendationRequest
+		wantID  string
+		wantErr error
+	}{
+		{"cheapest overall, longer context breaks the tie", RecommendationRequest{}, "budget-long", nil},
+		{"capability filter", RecommendationRequest{RequiredCapabilities: []string{"vision"}}, "standard", nil},
+		{"all capabilities required", RecommendationRequest{RequiredCapabilities: []string{"vision", "function-calling"}}, "standard", nil},
+		{"context filter", RecommendationRequest{MinContextSize: 150000}, "budget-long", nil},
+		{"context and capability", RecommendationRequest{MinContextSize: 150000, RequiredCapabilities: []string{"vision"}}, "premium", nil},
+		{"budget at the exact price", RecommendationRequest{MaxInputPricePerMillion: 2.5, RequiredCapabilities: []string{"vision"}}, "standard", nil},
+		{"budget excludes unpriced models", RecommendationRequest{MaxInputPricePerMillion: 1, RequiredCapabilities: []string{"vision"}}, "", ErrNoMatchingModel},
+		{"unknown capability", RecommendationRequest{RequiredCapabilities: []string{"audio"}}, "", ErrNoMatchingModel},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Models = recommendationCatalog()
+			model, err := RecommendModel(tt.req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && model.ID != tt.wantID {
+				t.Errorf("recommended %q, want %q", model.ID, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestRecommendModelFallsBackToUnpriced(t *testing.T) {
+	catalog := []*Model{
+		{ID: "unpriced", ContextSize: 32000, Capabilities: []string{"chat", "audio"}},
+		{ID: "priced", ContextSize: 128000, CostPerToken: 0.000001, Capabilities: []string{"chat"}},
+	}
+
+	model, err := RecommendModel(RecommendationRequest{Models: catalog, RequiredCapabilities: []string{"audio"}})
+	if err != nil {
+		t.Fatalf("RecommendModel: %v", err)
+	}
+	if model.ID != "unpriced" {
+		t.Errorf("recommended %q, want the only model with the capability", model.ID)
+	}
+}
@@ -0,0 +1,56 @@
+package models
+
+// ClassificationSummary contains aggregate statistics for a set of classified models
+type ClassificationSummary struct {
+	TotalModels       int32            `json:"total_models"`
+	ProviderCounts    map[string]int32 `json:"provider_counts,omitempty"`
+	TypeCounts        map[string]int32 `json:"type_counts,omitempty"`
+	CapabilityCounts  map[string]int32 `json:"capability_counts,omitempty"`
+	MultimodalCount   int32            `json:"multimodal_count"`
+	ExperimentalCount int32            `json:"experimental_count"`
+	MinContextSize    int32            `json:"min_context_size,omitempty"`
+	MaxContextSize    int32            `json:"max_context_size,omitempty"`
+	AvgContextSize    float64          `json:"avg_context_size,omitempty"`
+
+	// Running totals for models with a known context size
+	contextTotal int64
+	contextCount int64
+}
+
+// NewClassificationSummary creates an empty summary
+func NewClassificationSummary() *ClassificationSummary {
+	return &ClassificationSummary{
+		ProviderCounts:   make(map[string]int32),
+		TypeCounts:       make(map[string]int32),
+		CapabilityCounts: make(map[string]int32),
+	}
+}
+
+// Add accumulates a classified model into the summary
+func (s *ClassificationSummary) Add(model *Model) {
+	s.TotalModels++
+	s.ProviderCounts[model.Provider]++
+	s.TypeCounts[model.Type]++
+	for _, capability := range model.Capabilities {
+		s.CapabilityCounts[capability]++
+	}
+	if model.IsMultimodal {
+		s.MultimodalCount++
+	}
+	if model.IsExperimental {
+		s.ExperimentalCount++
+	}
+
+	// Context statistics only consider models with a known context size
+	if model.ContextSize > 0 {
+		if s.contextCount == 0 || model.ContextSize < s.MinContextSize {
+			s.MinContextSize = model.ContextSize
+		}
+		if model.ContextSize > s.MaxContextSize {
+			s.MaxContextSize = model.ContextSize
+		}
+		s.contextTotal += int64(model.ContextSize)
+		s.contextCount++
+		s.AvgContextSize = float64(s.contextTotal) / float64(s.contextCount)
+	}
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestClassificationSummaryAdd(t *testing.T) {
+	catalog := []*Model{
+		{ID: "gpt-4o", Provider: "openai", Type: "gpt-4o", ContextSize: 128000, Capabilities: []string{"chat", "vision"}, IsMultimodal: true},
+		{ID: "gpt-4o-mini", Provider: "openai", Type: "gpt-4o", ContextSize: 128000, Capabilities: []string{"chat", "vision"}, IsMultimodal: true},
+		{ID: "claude-3-haiku", Provider: "anthropic", Type: "claude-3", ContextSize: 200000, Capabilities: []string{"chat"}},
+		{ID: "gemini-2.0-flash-exp", Provider: "google", Type: "gemini-2.0", ContextSize: 1000000, Capabilities: []string{"chat", "vision", "audio"}, IsMultimodal: true, IsExperimental: true},
+		// Unknown context size: counted everywhere except the context statistics
+		{ID: "custom-model", Provider: "acme", Type: "other"},
+	}
+
+	summary := NewClassificationSummary()
+	for _, model := range catalog {
+		summary.Add(model)
+	}
+
+	if summary.TotalModels != 5 {
+		t.Errorf("TotalModels = %d, want 5", summary.TotalModels)
+	}
+	wantProviders := map[string]int32{"openai": 2, "anthropic": 1, "google": 1, "acme": 1}
+	if !reflect.DeepEqual(summary.ProviderCounts, wantProviders) {
+		t.Errorf("ProviderCounts = %v, want %v", summary.ProviderCounts, wantProviders)
+	}
+	wantTypes := map[string]int32{"gpt-4o": 2, "claude-3": 1, "gemini-2.0": 1, "other": 1}
+	if !reflect.DeepEqual(summary.TypeCounts, wantTypes) {
+		t.Errorf("TypeCounts = %v, want %v", summary.TypeCounts, wantTypes)
+	}
+	wantCapabilities := map[string]int32{"chat": 4, "vision": 3, "audio": 1}
+	if !reflect.DeepEqual(summary.CapabilityCounts, wantCapabilities) {
+		t.Errorf("CapabilityCounts = %v, want %v", summary.CapabilityCounts, wantCapabilities)
+	}
+	if summary.MultimodalCount != 3 {
+		t.Errorf("MultimodalCount = %d, want 3", summary.MultimodalCount)
+	}
+	if summary.ExperimentalCount != 1 {
+		t.Errorf("ExperimentalCount = %d, want 1", summary.ExperimentalCount)
+	}
+	if summary.MinContextSize != 128000 || summary.MaxContextSize != 1000000 {
+		t.Errorf("context range = [%d, %d], want [128000, 1000000]", summary.MinContextSize, summary.MaxContextSize)
+	}
+	if want := float64(128000+128000+200000+1000000) / 4; summary.AvgContextSize != want {
+		t.Errorf("AvgContextSize = %v, want %v", summary.AvgContextSize, want)
+	}
+}
+
+func TestClassificationSummaryEmpty(t *testing.T) {
+	summary := NewClassificationSummary()
+	if summary.TotalModels != 0 || summary.MinContextSize != 0 || summary.MaxContextSize != 0 || summary.AvgContextSize != 0 {
+		t.Errorf("empty summary = %+v, want zero values", summary)
+	}
+}
@@ -0,0 +1,25 @@
+package models
+
+// TreeModelsKey holds a group's own models in HierarchyToMap output when the group
+// also has child groups
+const TreeModelsKey = "_models"
+
+// HierarchyToMap converts hierarchical groups into nested objects keyed by group value,
+// e.g. {"openai": {"GPT 4": {"GPT-4o": [models]}}}. Leaf groups map to their model
+// list; a group with both models and children keeps its models under TreeModelsKey.
+func HierarchyToMap(groups []*HierarchicalModelGroup) map[string]interface{} {
+	result := make(map[string]interface{}, len(groups))
+	for _, group := range groups {
+		if len(group.Children) == 0 {
+			result[group.GroupValue] = group.Models
+			continue
+		}
+
+		children := HierarchyToMap(group.Children)
+		if len(group.Models) > 0 {
+			children[TreeModelsKey] = group.Models
+		}
+		result[group.GroupValue] = children
+	}
+	return result
+}
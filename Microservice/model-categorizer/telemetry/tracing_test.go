@@ -0,0 +1,26 @@
+package telemetry
+
+import "testing"
+
+func TestValidateEndpoint(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		wantErr  bool
+	}{
+		{"http://collector:4317", false},
+		{"https://collector.example.com:4317", false},
+		{"http://localhost:4317/", false},
+		{"collector:4317", true},
+		{"localhost", true},
+		{"grpc://collector:4317", true},
+		{"http://", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			if err := validateEndpoint(tt.endpoint); (err != nil) != tt.wantErr {
+				t.Errorf("validateEndpoint(%q) error = %v, want error %v", tt.endpoint, err, tt.wantErr)
+			}
+		})
+	}
+}
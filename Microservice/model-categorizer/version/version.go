@@ -0,0 +1,20 @@
+// Package version holds build information injected at link time, e.g.
+//
+//	go build -ldflags "-X github.com/chat-api/model-categorizer/version.Version=1.2.0 \
+//	  -X github.com/chat-api/model-categorizer/version.Commit=$(git rev-parse --short HEAD) \
+//	  -X github.com/chat-api/model-categorizer/version.BuildDate=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+package version
+
+import "fmt"
+
+// Build information; overridden with -ldflags "-X" at build time
+var (
+	Version   = "dev"
+	Commit    = "unknown"
+	BuildDate = "unknown"
+)
+
+// String formats the build information for logs and the -version flag
+func String() string {
+	return fmt.Sprintf("%s (commit %s, built %s)", Version, Commit, BuildDate)
+}